@@ -15,41 +15,239 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type roverBuilder struct{}
+type roverBuilder struct {
+	options []objects.RoverOption
+}
+
+func (r *roverBuilder) LaunchRover(h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	return objects.Rover{}.LaunchRover(h, p, env, r.options...)
+}
 
-func (*roverBuilder) LaunchRover(h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
-	return objects.Rover{}.LaunchRover(h, p, env)
+func (r *roverBuilder) RestoreRover(id string, h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	return objects.Rover{}.RestoreRover(id, h, p, env, r.options...)
 }
 
-type envBuilder struct{}
+type envBuilder struct {
+	heightMap *environment.HeightMap
+	mask      *environment.Mask
+	storage   environment.PlateauOption
+}
 
-func (*envBuilder) NewEnvironment(p spatial.Point) environmentiface.Environmenter {
-	return environment.Plateau{}.NewPlateau(p)
+func (e *envBuilder) NewEnvironment(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+	plateau, err := environment.Plateau{}.NewBoundedPlateau(b, e.storage)
+	if err != nil {
+		return nil, err
+	}
+	if err := plateau.SetHeightMap(e.heightMap); err != nil {
+		return nil, err
+	}
+	if err := plateau.SetMask(e.mask); err != nil {
+		return nil, err
+	}
+	return plateau, nil
+}
+
+type torusBuilder struct {
+	heightMap *environment.HeightMap
+	mask      *environment.Mask
+	storage   environment.PlateauOption
+}
+
+func (t *torusBuilder) NewEnvironment(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+	torus, err := environment.Torus{}.NewBoundedTorus(b, t.storage)
+	if err != nil {
+		return nil, err
+	}
+	if err := torus.SetHeightMap(t.heightMap); err != nil {
+		return nil, err
+	}
+	if err := torus.SetMask(t.mask); err != nil {
+		return nil, err
+	}
+	return torus, nil
 }
 
 var rootCmd = &cobra.Command{
 	Use:   "marsrover",
 	Short: "A system that simulates exploring mars.",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		mission := missioncontrol.NewMission(new(envBuilder), new(roverBuilder))
+		mission, err := newMission()
+		if err != nil {
+			return err
+		}
 
-		data, err := ioutil.ReadAll(os.Stdin)
+		return mission.ExecuteMissionStream(os.Stdin, func(report missioncontrol.RoverReport) {
+			status := report.String()
+			if heightMapPath != "" {
+				status = fmt.Sprintf("%v elevation=%v", status, report.FinalElevation)
+			}
+			if showIDs {
+				status = fmt.Sprintf("%v id=%v", status, report.ID)
+			}
+			fmt.Println(status)
+		})
+	},
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Checks a mission for problems without executing it.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mission, err := newMission()
 		if err != nil {
 			return err
 		}
 
-		commands := strings.Split(string(data), "\n")
-		stats, err := mission.ExecuteMission(commands)
+		data, err := ioutil.ReadAll(os.Stdin)
 		if err != nil {
 			return err
 		}
-		for _, stat := range stats {
-			fmt.Println(stat)
+
+		problems := mission.Validate(strings.Split(string(data), "\n"))
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("found %v problem(s) with the mission", len(problems))
 		}
 		return nil
 	},
 }
 
+var (
+	continueOnError bool
+	stepBudget      int
+	onCollision     string
+	onBoundary      string
+	onLowEnergy     string
+	topology        string
+	heightMapPath   string
+	maxSlope        int
+	maskPath        string
+	expectedObjects int
+	battery         objects.BatteryConfig
+	showIDs         bool
+)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&continueOnError, "continue-on-error", false,
+		"keep deploying the remaining rovers if a rover fails, and report the failures once the mission ends")
+	rootCmd.PersistentFlags().IntVar(&stepBudget, "step-budget", 0,
+		"the maximum number of instructions each rover may execute (0 for no limit)")
+	rootCmd.PersistentFlags().StringVar(&onCollision, "on-collision", "skip",
+		"how to handle a move blocked by another object (skip, stop, or fail)")
+	rootCmd.PersistentFlags().StringVar(&onBoundary, "on-boundary", "fail",
+		"how to handle a move blocked by the edge of the plateau (skip, stop, or fail)")
+	rootCmd.PersistentFlags().StringVar(&onLowEnergy, "on-low-energy", "skip",
+		"how to handle a move or turn that a rover's battery has too little energy for (skip, stop, or fail)")
+	rootCmd.PersistentFlags().StringVar(&topology, "topology", "plateau",
+		"the shape of the environment (plateau, or torus to wrap around the edges)")
+	rootCmd.PersistentFlags().StringVar(&heightMapPath, "height-map", "",
+		"a grid file describing the elevation of each position (the status of each rover then includes its elevation)")
+	rootCmd.PersistentFlags().IntVar(&maxSlope, "max-slope", -1,
+		"the largest change in elevation each rover can manage in a single move (-1 for no limit)")
+	rootCmd.PersistentFlags().StringVar(&maskPath, "mask", "",
+		"a grid file describing which positions are part of the environment (1) and which are not (0)")
+	rootCmd.PersistentFlags().IntVar(&expectedObjects, "expected-objects", 0,
+		"an estimate of the number of rovers and obstacles in the mission, used to choose how the environment stores them")
+	rootCmd.PersistentFlags().IntVar(&battery.Capacity, "battery-capacity", 0,
+		"the energy held by each rover's battery (0 for no battery); the status of each rover then includes its remaining energy")
+	rootCmd.PersistentFlags().IntVar(&battery.MoveCost, "move-cost", 1,
+		"the energy consumed by each move of a battery powered rover")
+	rootCmd.PersistentFlags().IntVar(&battery.TurnCost, "turn-cost", 0,
+		"the energy consumed by each turn of a battery powered rover")
+	rootCmd.PersistentFlags().IntVar(&battery.ChargeRate, "charge-rate", 1,
+		"the energy restored to a battery powered rover by each W (wait and charge) instruction")
+	rootCmd.Flags().BoolVar(&showIDs, "show-ids", false,
+		"include each rover's ID (rover-1, rover-2, etc., for use with retire commands) in its status")
+	rootCmd.AddCommand(validateCmd)
+}
+
+// newMission constructs a mission configured according to the command line
+// flags.
+func newMission() (*missioncontrol.Mission, error) {
+	var options []missioncontrol.Option
+	if continueOnError {
+		options = append(options, missioncontrol.ContinueOnError())
+	}
+	if stepBudget > 0 {
+		options = append(options, missioncontrol.StepBudget(stepBudget))
+	}
+
+	collisionPolicy := missioncontrol.PolicyFromString(onCollision)
+	if collisionPolicy == missioncontrol.PolicyUnknown {
+		return nil, fmt.Errorf("unknown collision policy '%v'", onCollision)
+	}
+	boundaryPolicy := missioncontrol.PolicyFromString(onBoundary)
+	if boundaryPolicy == missioncontrol.PolicyUnknown {
+		return nil, fmt.Errorf("unknown boundary policy '%v'", onBoundary)
+	}
+	energyPolicy := missioncontrol.PolicyFromString(onLowEnergy)
+	if energyPolicy == missioncontrol.PolicyUnknown {
+		return nil, fmt.Errorf("unknown energy policy '%v'", onLowEnergy)
+	}
+	options = append(options,
+		missioncontrol.CollisionPolicy(collisionPolicy),
+		missioncontrol.BoundaryPolicy(boundaryPolicy),
+		missioncontrol.EnergyPolicy(energyPolicy),
+	)
+
+	var heightMap *environment.HeightMap
+	if heightMapPath != "" {
+		file, err := os.Open(heightMapPath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		heightMap, err = environment.HeightMap{}.LoadHeightMap(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var mask *environment.Mask
+	if maskPath != "" {
+		file, err := os.Open(maskPath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		mask, err = environment.Mask{}.LoadGridMask(file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	storage := environment.AutoStorage(expectedObjects)
+	var builder environmentiface.EnvironmentBuilder
+	switch topology {
+	case "plateau":
+		builder = &envBuilder{heightMap: heightMap, mask: mask, storage: storage}
+	case "torus":
+		builder = &torusBuilder{heightMap: heightMap, mask: mask, storage: storage}
+	default:
+		return nil, fmt.Errorf("unknown topology '%v'", topology)
+	}
+
+	rovers := new(roverBuilder)
+	if maxSlope >= 0 {
+		rovers.options = append(rovers.options, objects.MaxSlope(maxSlope))
+	}
+	if battery.Capacity > 0 {
+		if battery.MoveCost < 0 || battery.TurnCost < 0 || battery.ChargeRate < 0 {
+			return nil, fmt.Errorf("battery costs and charge rate cannot be negative")
+		}
+		rovers.options = append(rovers.options, objects.Battery(battery))
+	}
+
+	// Validation simulates scratch rovers, configured like the mission's rovers.
+	options = append(options, missioncontrol.ValidationRoverOptions(rovers.options...))
+
+	return missioncontrol.NewMission(builder, rovers, options...), nil
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
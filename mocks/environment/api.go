@@ -37,11 +37,12 @@ func (m *MockEnvironmentBuilder) EXPECT() *MockEnvironmentBuilderMockRecorder {
 }
 
 // NewEnvironment mocks base method
-func (m *MockEnvironmentBuilder) NewEnvironment(arg0 spatial.Point) environmentiface.Environmenter {
+func (m *MockEnvironmentBuilder) NewEnvironment(arg0 spatial.Rectangle) (environmentiface.Environmenter, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewEnvironment", arg0)
 	ret0, _ := ret[0].(environmentiface.Environmenter)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // NewEnvironment indicates an expected call of NewEnvironment
@@ -87,6 +88,20 @@ func (mr *MockEnvironmenterMockRecorder) GetDimensions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDimensions", reflect.TypeOf((*MockEnvironmenter)(nil).GetDimensions))
 }
 
+// GetBounds mocks base method
+func (m *MockEnvironmenter) GetBounds() spatial.Rectangle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBounds")
+	ret0, _ := ret[0].(spatial.Rectangle)
+	return ret0
+}
+
+// GetBounds indicates an expected call of GetBounds
+func (mr *MockEnvironmenterMockRecorder) GetBounds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBounds", reflect.TypeOf((*MockEnvironmenter)(nil).GetBounds))
+}
+
 // PlaceObject mocks base method
 func (m *MockEnvironmenter) PlaceObject(arg0 objectiface.Objecter, arg1 spatial.Point) error {
 	m.ctrl.T.Helper()
@@ -115,6 +130,20 @@ func (mr *MockEnvironmenterMockRecorder) RecordMovement(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovement", reflect.TypeOf((*MockEnvironmenter)(nil).RecordMovement), arg0, arg1)
 }
 
+// RemoveObject mocks base method
+func (m *MockEnvironmenter) RemoveObject(arg0 objectiface.Objecter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveObject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveObject indicates an expected call of RemoveObject
+func (mr *MockEnvironmenterMockRecorder) RemoveObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveObject", reflect.TypeOf((*MockEnvironmenter)(nil).RemoveObject), arg0)
+}
+
 // ShowObjects mocks base method
 func (m *MockEnvironmenter) ShowObjects() map[spatial.Point][]objectiface.Objecter {
 	m.ctrl.T.Helper()
@@ -159,3 +188,469 @@ func (mr *MockEnvironmenterMockRecorder) InspectPosition(arg0 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectPosition", reflect.TypeOf((*MockEnvironmenter)(nil).InspectPosition), arg0)
 }
+
+// MockElevationMapper is a mock of ElevationMapper interface
+type MockElevationMapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockElevationMapperMockRecorder
+}
+
+// MockElevationMapperMockRecorder is the mock recorder for MockElevationMapper
+type MockElevationMapperMockRecorder struct {
+	mock *MockElevationMapper
+}
+
+// NewMockElevationMapper creates a new mock instance
+func NewMockElevationMapper(ctrl *gomock.Controller) *MockElevationMapper {
+	mock := &MockElevationMapper{ctrl: ctrl}
+	mock.recorder = &MockElevationMapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockElevationMapper) EXPECT() *MockElevationMapperMockRecorder {
+	return m.recorder
+}
+
+// Elevation mocks base method
+func (m *MockElevationMapper) Elevation(arg0 spatial.Point) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Elevation", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Elevation indicates an expected call of Elevation
+func (mr *MockElevationMapperMockRecorder) Elevation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Elevation", reflect.TypeOf((*MockElevationMapper)(nil).Elevation), arg0)
+}
+
+// MockPositionNormalizer is a mock of PositionNormalizer interface
+type MockPositionNormalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionNormalizerMockRecorder
+}
+
+// MockPositionNormalizerMockRecorder is the mock recorder for MockPositionNormalizer
+type MockPositionNormalizerMockRecorder struct {
+	mock *MockPositionNormalizer
+}
+
+// NewMockPositionNormalizer creates a new mock instance
+func NewMockPositionNormalizer(ctrl *gomock.Controller) *MockPositionNormalizer {
+	mock := &MockPositionNormalizer{ctrl: ctrl}
+	mock.recorder = &MockPositionNormalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPositionNormalizer) EXPECT() *MockPositionNormalizerMockRecorder {
+	return m.recorder
+}
+
+// Normalize mocks base method
+func (m *MockPositionNormalizer) Normalize(arg0 spatial.Point) spatial.Point {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Normalize", arg0)
+	ret0, _ := ret[0].(spatial.Point)
+	return ret0
+}
+
+// Normalize indicates an expected call of Normalize
+func (mr *MockPositionNormalizerMockRecorder) Normalize(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Normalize", reflect.TypeOf((*MockPositionNormalizer)(nil).Normalize), arg0)
+}
+
+// MockObservable is a mock of Observable interface
+type MockObservable struct {
+	ctrl     *gomock.Controller
+	recorder *MockObservableMockRecorder
+}
+
+// MockObservableMockRecorder is the mock recorder for MockObservable
+type MockObservableMockRecorder struct {
+	mock *MockObservable
+}
+
+// NewMockObservable creates a new mock instance
+func NewMockObservable(ctrl *gomock.Controller) *MockObservable {
+	mock := &MockObservable{ctrl: ctrl}
+	mock.recorder = &MockObservableMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockObservable) EXPECT() *MockObservableMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method
+func (m *MockObservable) Subscribe(arg0 environmenttypes.Listener) func() {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0)
+	ret0, _ := ret[0].(func())
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockObservableMockRecorder) Subscribe(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockObservable)(nil).Subscribe), arg0)
+}
+
+// MockVacancyChecker is a mock of VacancyChecker interface
+type MockVacancyChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockVacancyCheckerMockRecorder
+}
+
+// MockVacancyCheckerMockRecorder is the mock recorder for MockVacancyChecker
+type MockVacancyCheckerMockRecorder struct {
+	mock *MockVacancyChecker
+}
+
+// NewMockVacancyChecker creates a new mock instance
+func NewMockVacancyChecker(ctrl *gomock.Controller) *MockVacancyChecker {
+	mock := &MockVacancyChecker{ctrl: ctrl}
+	mock.recorder = &MockVacancyCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVacancyChecker) EXPECT() *MockVacancyCheckerMockRecorder {
+	return m.recorder
+}
+
+// GetDimensions mocks base method
+func (m *MockVacancyChecker) GetDimensions() spatial.Point {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDimensions")
+	ret0, _ := ret[0].(spatial.Point)
+	return ret0
+}
+
+// GetDimensions indicates an expected call of GetDimensions
+func (mr *MockVacancyCheckerMockRecorder) GetDimensions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDimensions", reflect.TypeOf((*MockVacancyChecker)(nil).GetDimensions))
+}
+
+// GetBounds mocks base method
+func (m *MockVacancyChecker) GetBounds() spatial.Rectangle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBounds")
+	ret0, _ := ret[0].(spatial.Rectangle)
+	return ret0
+}
+
+// GetBounds indicates an expected call of GetBounds
+func (mr *MockVacancyCheckerMockRecorder) GetBounds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBounds", reflect.TypeOf((*MockVacancyChecker)(nil).GetBounds))
+}
+
+// PlaceObject mocks base method
+func (m *MockVacancyChecker) PlaceObject(arg0 objectiface.Objecter, arg1 spatial.Point) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceObject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceObject indicates an expected call of PlaceObject
+func (mr *MockVacancyCheckerMockRecorder) PlaceObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceObject", reflect.TypeOf((*MockVacancyChecker)(nil).PlaceObject), arg0, arg1)
+}
+
+// RecordMovement mocks base method
+func (m *MockVacancyChecker) RecordMovement(arg0 objectiface.Objecter, arg1 spatial.Point) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMovement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordMovement indicates an expected call of RecordMovement
+func (mr *MockVacancyCheckerMockRecorder) RecordMovement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovement", reflect.TypeOf((*MockVacancyChecker)(nil).RecordMovement), arg0, arg1)
+}
+
+// RemoveObject mocks base method
+func (m *MockVacancyChecker) RemoveObject(arg0 objectiface.Objecter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveObject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveObject indicates an expected call of RemoveObject
+func (mr *MockVacancyCheckerMockRecorder) RemoveObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveObject", reflect.TypeOf((*MockVacancyChecker)(nil).RemoveObject), arg0)
+}
+
+// ShowObjects mocks base method
+func (m *MockVacancyChecker) ShowObjects() map[spatial.Point][]objectiface.Objecter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowObjects")
+	ret0, _ := ret[0].(map[spatial.Point][]objectiface.Objecter)
+	return ret0
+}
+
+// ShowObjects indicates an expected call of ShowObjects
+func (mr *MockVacancyCheckerMockRecorder) ShowObjects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowObjects", reflect.TypeOf((*MockVacancyChecker)(nil).ShowObjects))
+}
+
+// FindObject mocks base method
+func (m *MockVacancyChecker) FindObject(arg0 objectiface.Objecter) (bool, *environmenttypes.ObjectPosition) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindObject", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*environmenttypes.ObjectPosition)
+	return ret0, ret1
+}
+
+// FindObject indicates an expected call of FindObject
+func (mr *MockVacancyCheckerMockRecorder) FindObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindObject", reflect.TypeOf((*MockVacancyChecker)(nil).FindObject), arg0)
+}
+
+// InspectPosition mocks base method
+func (m *MockVacancyChecker) InspectPosition(arg0 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InspectPosition", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InspectPosition indicates an expected call of InspectPosition
+func (mr *MockVacancyCheckerMockRecorder) InspectPosition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectPosition", reflect.TypeOf((*MockVacancyChecker)(nil).InspectPosition), arg0)
+}
+
+// PlaceObjectIfVacant mocks base method
+func (m *MockVacancyChecker) PlaceObjectIfVacant(arg0 objectiface.Objecter, arg1 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceObjectIfVacant", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PlaceObjectIfVacant indicates an expected call of PlaceObjectIfVacant
+func (mr *MockVacancyCheckerMockRecorder) PlaceObjectIfVacant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceObjectIfVacant", reflect.TypeOf((*MockVacancyChecker)(nil).PlaceObjectIfVacant), arg0, arg1)
+}
+
+// MoveObjectIfVacant mocks base method
+func (m *MockVacancyChecker) MoveObjectIfVacant(arg0 objectiface.Objecter, arg1 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveObjectIfVacant", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveObjectIfVacant indicates an expected call of MoveObjectIfVacant
+func (mr *MockVacancyCheckerMockRecorder) MoveObjectIfVacant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveObjectIfVacant", reflect.TypeOf((*MockVacancyChecker)(nil).MoveObjectIfVacant), arg0, arg1)
+}
+
+// MockAtomicEnvironmenter is a mock of AtomicEnvironmenter interface
+type MockAtomicEnvironmenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAtomicEnvironmenterMockRecorder
+}
+
+// MockAtomicEnvironmenterMockRecorder is the mock recorder for MockAtomicEnvironmenter
+type MockAtomicEnvironmenterMockRecorder struct {
+	mock *MockAtomicEnvironmenter
+}
+
+// NewMockAtomicEnvironmenter creates a new mock instance
+func NewMockAtomicEnvironmenter(ctrl *gomock.Controller) *MockAtomicEnvironmenter {
+	mock := &MockAtomicEnvironmenter{ctrl: ctrl}
+	mock.recorder = &MockAtomicEnvironmenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAtomicEnvironmenter) EXPECT() *MockAtomicEnvironmenterMockRecorder {
+	return m.recorder
+}
+
+// GetDimensions mocks base method
+func (m *MockAtomicEnvironmenter) GetDimensions() spatial.Point {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDimensions")
+	ret0, _ := ret[0].(spatial.Point)
+	return ret0
+}
+
+// GetDimensions indicates an expected call of GetDimensions
+func (mr *MockAtomicEnvironmenterMockRecorder) GetDimensions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDimensions", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).GetDimensions))
+}
+
+// GetBounds mocks base method
+func (m *MockAtomicEnvironmenter) GetBounds() spatial.Rectangle {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBounds")
+	ret0, _ := ret[0].(spatial.Rectangle)
+	return ret0
+}
+
+// GetBounds indicates an expected call of GetBounds
+func (mr *MockAtomicEnvironmenterMockRecorder) GetBounds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBounds", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).GetBounds))
+}
+
+// PlaceObject mocks base method
+func (m *MockAtomicEnvironmenter) PlaceObject(arg0 objectiface.Objecter, arg1 spatial.Point) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceObject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceObject indicates an expected call of PlaceObject
+func (mr *MockAtomicEnvironmenterMockRecorder) PlaceObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceObject", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).PlaceObject), arg0, arg1)
+}
+
+// RecordMovement mocks base method
+func (m *MockAtomicEnvironmenter) RecordMovement(arg0 objectiface.Objecter, arg1 spatial.Point) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMovement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordMovement indicates an expected call of RecordMovement
+func (mr *MockAtomicEnvironmenterMockRecorder) RecordMovement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMovement", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).RecordMovement), arg0, arg1)
+}
+
+// RemoveObject mocks base method
+func (m *MockAtomicEnvironmenter) RemoveObject(arg0 objectiface.Objecter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveObject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveObject indicates an expected call of RemoveObject
+func (mr *MockAtomicEnvironmenterMockRecorder) RemoveObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveObject", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).RemoveObject), arg0)
+}
+
+// ShowObjects mocks base method
+func (m *MockAtomicEnvironmenter) ShowObjects() map[spatial.Point][]objectiface.Objecter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowObjects")
+	ret0, _ := ret[0].(map[spatial.Point][]objectiface.Objecter)
+	return ret0
+}
+
+// ShowObjects indicates an expected call of ShowObjects
+func (mr *MockAtomicEnvironmenterMockRecorder) ShowObjects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowObjects", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).ShowObjects))
+}
+
+// FindObject mocks base method
+func (m *MockAtomicEnvironmenter) FindObject(arg0 objectiface.Objecter) (bool, *environmenttypes.ObjectPosition) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindObject", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*environmenttypes.ObjectPosition)
+	return ret0, ret1
+}
+
+// FindObject indicates an expected call of FindObject
+func (mr *MockAtomicEnvironmenterMockRecorder) FindObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindObject", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).FindObject), arg0)
+}
+
+// InspectPosition mocks base method
+func (m *MockAtomicEnvironmenter) InspectPosition(arg0 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InspectPosition", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InspectPosition indicates an expected call of InspectPosition
+func (mr *MockAtomicEnvironmenterMockRecorder) InspectPosition(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectPosition", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).InspectPosition), arg0)
+}
+
+// PlaceObjectIfVacant mocks base method
+func (m *MockAtomicEnvironmenter) PlaceObjectIfVacant(arg0 objectiface.Objecter, arg1 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceObjectIfVacant", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PlaceObjectIfVacant indicates an expected call of PlaceObjectIfVacant
+func (mr *MockAtomicEnvironmenterMockRecorder) PlaceObjectIfVacant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceObjectIfVacant", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).PlaceObjectIfVacant), arg0, arg1)
+}
+
+// MoveObjectIfVacant mocks base method
+func (m *MockAtomicEnvironmenter) MoveObjectIfVacant(arg0 objectiface.Objecter, arg1 spatial.Point) (bool, []objectiface.Objecter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveObjectIfVacant", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]objectiface.Objecter)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveObjectIfVacant indicates an expected call of MoveObjectIfVacant
+func (mr *MockAtomicEnvironmenterMockRecorder) MoveObjectIfVacant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveObjectIfVacant", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).MoveObjectIfVacant), arg0, arg1)
+}
+
+// Atomically mocks base method
+func (m *MockAtomicEnvironmenter) Atomically(fn func(environmentiface.Environmenter) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Atomically", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Atomically indicates an expected call of Atomically
+func (mr *MockAtomicEnvironmenterMockRecorder) Atomically(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Atomically", reflect.TypeOf((*MockAtomicEnvironmenter)(nil).Atomically), fn)
+}
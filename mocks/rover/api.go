@@ -8,6 +8,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	environmentiface "github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
 	roveriface "github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	rovertypes "github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	spatial "github.com/jecolasurdo/marsrover/pkg/spatial"
 	reflect "reflect"
 )
@@ -50,6 +51,59 @@ func (mr *MockRoverBuilderMockRecorder) LaunchRover(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaunchRover", reflect.TypeOf((*MockRoverBuilder)(nil).LaunchRover), arg0, arg1, arg2)
 }
 
+// MockRoverRestorer is a mock of RoverRestorer interface
+type MockRoverRestorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoverRestorerMockRecorder
+}
+
+// MockRoverRestorerMockRecorder is the mock recorder for MockRoverRestorer
+type MockRoverRestorerMockRecorder struct {
+	mock *MockRoverRestorer
+}
+
+// NewMockRoverRestorer creates a new mock instance
+func NewMockRoverRestorer(ctrl *gomock.Controller) *MockRoverRestorer {
+	mock := &MockRoverRestorer{ctrl: ctrl}
+	mock.recorder = &MockRoverRestorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRoverRestorer) EXPECT() *MockRoverRestorerMockRecorder {
+	return m.recorder
+}
+
+// LaunchRover mocks base method
+func (m *MockRoverRestorer) LaunchRover(arg0 spatial.Heading, arg1 spatial.Point, arg2 environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LaunchRover", arg0, arg1, arg2)
+	ret0, _ := ret[0].(roveriface.RoverAPI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LaunchRover indicates an expected call of LaunchRover
+func (mr *MockRoverRestorerMockRecorder) LaunchRover(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaunchRover", reflect.TypeOf((*MockRoverRestorer)(nil).LaunchRover), arg0, arg1, arg2)
+}
+
+// RestoreRover mocks base method
+func (m *MockRoverRestorer) RestoreRover(arg0 string, arg1 spatial.Heading, arg2 spatial.Point, arg3 environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRover", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(roveriface.RoverAPI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRover indicates an expected call of RestoreRover
+func (mr *MockRoverRestorerMockRecorder) RestoreRover(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRover", reflect.TypeOf((*MockRoverRestorer)(nil).RestoreRover), arg0, arg1, arg2, arg3)
+}
+
 // MockRoverAPI is a mock of RoverAPI interface
 type MockRoverAPI struct {
 	ctrl     *gomock.Controller
@@ -117,9 +171,11 @@ func (mr *MockRoverAPIMockRecorder) CurrentHeading() *gomock.Call {
 }
 
 // ChangeHeading mocks base method
-func (m *MockRoverAPI) ChangeHeading(arg0 spatial.Direction) {
+func (m *MockRoverAPI) ChangeHeading(arg0 spatial.Direction) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ChangeHeading", arg0)
+	ret := m.ctrl.Call(m, "ChangeHeading", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // ChangeHeading indicates an expected call of ChangeHeading
@@ -141,3 +197,142 @@ func (mr *MockRoverAPIMockRecorder) Move() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockRoverAPI)(nil).Move))
 }
+
+// Reverse mocks base method
+func (m *MockRoverAPI) Reverse() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reverse")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reverse indicates an expected call of Reverse
+func (mr *MockRoverAPIMockRecorder) Reverse() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reverse", reflect.TypeOf((*MockRoverAPI)(nil).Reverse))
+}
+
+// MockElevationReporter is a mock of ElevationReporter interface
+type MockElevationReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockElevationReporterMockRecorder
+}
+
+// MockElevationReporterMockRecorder is the mock recorder for MockElevationReporter
+type MockElevationReporterMockRecorder struct {
+	mock *MockElevationReporter
+}
+
+// NewMockElevationReporter creates a new mock instance
+func NewMockElevationReporter(ctrl *gomock.Controller) *MockElevationReporter {
+	mock := &MockElevationReporter{ctrl: ctrl}
+	mock.recorder = &MockElevationReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockElevationReporter) EXPECT() *MockElevationReporterMockRecorder {
+	return m.recorder
+}
+
+// CurrentElevation mocks base method
+func (m *MockElevationReporter) CurrentElevation() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentElevation")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentElevation indicates an expected call of CurrentElevation
+func (mr *MockElevationReporterMockRecorder) CurrentElevation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentElevation", reflect.TypeOf((*MockElevationReporter)(nil).CurrentElevation))
+}
+
+// MockTelemetryReporter is a mock of TelemetryReporter interface
+type MockTelemetryReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTelemetryReporterMockRecorder
+}
+
+// MockTelemetryReporterMockRecorder is the mock recorder for MockTelemetryReporter
+type MockTelemetryReporterMockRecorder struct {
+	mock *MockTelemetryReporter
+}
+
+// NewMockTelemetryReporter creates a new mock instance
+func NewMockTelemetryReporter(ctrl *gomock.Controller) *MockTelemetryReporter {
+	mock := &MockTelemetryReporter{ctrl: ctrl}
+	mock.recorder = &MockTelemetryReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTelemetryReporter) EXPECT() *MockTelemetryReporterMockRecorder {
+	return m.recorder
+}
+
+// Telemetry mocks base method
+func (m *MockTelemetryReporter) Telemetry() rovertypes.Telemetry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Telemetry")
+	ret0, _ := ret[0].(rovertypes.Telemetry)
+	return ret0
+}
+
+// Telemetry indicates an expected call of Telemetry
+func (mr *MockTelemetryReporterMockRecorder) Telemetry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Telemetry", reflect.TypeOf((*MockTelemetryReporter)(nil).Telemetry))
+}
+
+// MockBatteryPowered is a mock of BatteryPowered interface
+type MockBatteryPowered struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatteryPoweredMockRecorder
+}
+
+// MockBatteryPoweredMockRecorder is the mock recorder for MockBatteryPowered
+type MockBatteryPoweredMockRecorder struct {
+	mock *MockBatteryPowered
+}
+
+// NewMockBatteryPowered creates a new mock instance
+func NewMockBatteryPowered(ctrl *gomock.Controller) *MockBatteryPowered {
+	mock := &MockBatteryPowered{ctrl: ctrl}
+	mock.recorder = &MockBatteryPoweredMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBatteryPowered) EXPECT() *MockBatteryPoweredMockRecorder {
+	return m.recorder
+}
+
+// RemainingEnergy mocks base method
+func (m *MockBatteryPowered) RemainingEnergy() (int, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemainingEnergy")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RemainingEnergy indicates an expected call of RemainingEnergy
+func (mr *MockBatteryPoweredMockRecorder) RemainingEnergy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemainingEnergy", reflect.TypeOf((*MockBatteryPowered)(nil).RemainingEnergy))
+}
+
+// Charge mocks base method
+func (m *MockBatteryPowered) Charge() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Charge")
+}
+
+// Charge indicates an expected call of Charge
+func (mr *MockBatteryPoweredMockRecorder) Charge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Charge", reflect.TypeOf((*MockBatteryPowered)(nil).Charge))
+}
@@ -0,0 +1,56 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttest"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// Test_Conformance verifies that each of the environments (and each of the
+// plateau's storage backends) honors the environment contracts.
+func Test_Conformance(t *testing.T) {
+	t.Run("sparse plateau", func(t *testing.T) {
+		environmenttest.TestVacancyChecker(t, func(b spatial.Rectangle) (environmentiface.VacancyChecker, error) {
+			return environment.Plateau{}.NewBoundedPlateau(b)
+		})
+	})
+
+	t.Run("dense plateau", func(t *testing.T) {
+		environmenttest.TestVacancyChecker(t, func(b spatial.Rectangle) (environmentiface.VacancyChecker, error) {
+			return environment.Plateau{}.NewBoundedPlateau(b, environment.DenseStorage())
+		})
+	})
+
+	t.Run("plateau builder", func(t *testing.T) {
+		environmenttest.TestEnvironmenter(t, (&environment.PlateauBuilder{ExpectedObjects: 100}).NewEnvironment)
+	})
+
+	t.Run("sync environment", func(t *testing.T) {
+		environmenttest.TestAtomicEnvironmenter(t, func(b spatial.Rectangle) (environmentiface.AtomicEnvironmenter, error) {
+			plateau, err := environment.Plateau{}.NewBoundedPlateau(b, environment.DenseStorage())
+			if err != nil {
+				return nil, err
+			}
+			return environment.SyncEnvironment{}.NewSyncEnvironment(plateau), nil
+		})
+	})
+
+	t.Run("torus", func(t *testing.T) {
+		environmenttest.TestVacancyChecker(t, func(b spatial.Rectangle) (environmentiface.VacancyChecker, error) {
+			return environment.Torus{}.NewBoundedTorus(b)
+		}, environmenttest.Wrapping())
+	})
+
+	t.Run("sync torus", func(t *testing.T) {
+		environmenttest.TestAtomicEnvironmenter(t, func(b spatial.Rectangle) (environmentiface.AtomicEnvironmenter, error) {
+			torus, err := environment.Torus{}.NewBoundedTorus(b)
+			if err != nil {
+				return nil, err
+			}
+			return environment.SyncEnvironment{}.NewSyncEnvironment(torus), nil
+		}, environmenttest.Wrapping())
+	})
+}
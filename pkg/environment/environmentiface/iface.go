@@ -10,7 +10,9 @@ import (
 // EnvironmentBuilder is anything that knows how to construct an abstract
 // environment.
 type EnvironmentBuilder interface {
-	NewEnvironment(spatial.Point) Environmenter
+	// NewEnvironment constructs an environment spanning the supplied bounds,
+	// or returns an error if no such environment can be constructed.
+	NewEnvironment(spatial.Rectangle) (Environmenter, error)
 }
 
 // Environmenter is anything that can describe an environment.
@@ -18,6 +20,9 @@ type Environmenter interface {
 	// GetDimenstions returns the dimension of the environment.
 	GetDimensions() spatial.Point
 
+	// GetBounds returns the region of the plane that the environment spans.
+	GetBounds() spatial.Rectangle
+
 	// PlaceObject inserts a new object into the environment at some position.
 	// The environment will enforce unique object ID's for consistency.
 	PlaceObject(objectiface.Objecter, spatial.Point) error
@@ -26,6 +31,11 @@ type Environmenter interface {
 	// environment to another.
 	RecordMovement(objectiface.Objecter, spatial.Point) error
 
+	// RemoveObject takes an object out of the environment, so that the object
+	// no longer occupies any position. The environment must return an error if
+	// the object is not present within the environment.
+	RemoveObject(objectiface.Objecter) error
+
 	// ShowObjects returns a sparse map of points within the terrain that
 	// contain objects.
 	ShowObjects() map[spatial.Point][]objectiface.Objecter
@@ -48,3 +58,81 @@ type Environmenter interface {
 	// the method must return false, nil, and an error.
 	InspectPosition(spatial.Point) (bool, []objectiface.Objecter, error)
 }
+
+// ElevationMapper is an environment whose positions may have differing
+// elevations.
+type ElevationMapper interface {
+	// Elevation returns the elevation of a position within the environment.
+	// If the position does not exist within the environment, the method must
+	// return an error.
+	Elevation(spatial.Point) (int, error)
+}
+
+// PositionNormalizer is an environment in which several points of the plane
+// can refer to the same position (such as an environment whose edges wrap
+// around).
+type PositionNormalizer interface {
+	// Normalize returns the point within the environment's bounds that refers
+	// to the same position as the supplied point.
+	Normalize(spatial.Point) spatial.Point
+}
+
+// Observable is an environment that reports the events that occur within it
+// to subscribed listeners.
+type Observable interface {
+	// Subscribe registers a listener, which will receive each subsequent
+	// event, in the order in which the events occur. Calling the returned
+	// function removes the listener.
+	//
+	// Listeners are called synchronously, so they must not block, and must
+	// not call back into the environment.
+	Subscribe(environmenttypes.Listener) (unsubscribe func())
+}
+
+// VacancyChecker is an environment that can check that a position is vacant
+// and occupy that position in a single call. Because the environment learns
+// which object attempted to occupy the position, it can report movements that
+// it rejects on the object's behalf (see Observable).
+type VacancyChecker interface {
+	Environmenter
+
+	// PlaceObjectIfVacant inserts a new object into the environment at some
+	// position, but only if no other objects are present at that position.
+	//
+	// If the object is placed, this method must return true, a nil slice,
+	// and a nil error.
+	//
+	// If the position is occupied, this method must return false, the
+	// non-empty list of objects present at the position, and a nil error.
+	//
+	// If the object cannot be placed for any other reason, this method must
+	// return false, nil, and an error.
+	PlaceObjectIfVacant(objectiface.Objecter, spatial.Point) (bool, []objectiface.Objecter, error)
+
+	// MoveObjectIfVacant records the movement of an object from one position
+	// in the environment to another, but only if no other objects are present
+	// at the new position. The return values follow the same rules as
+	// PlaceObjectIfVacant.
+	MoveObjectIfVacant(objectiface.Objecter, spatial.Point) (bool, []objectiface.Objecter, error)
+}
+
+// AtomicEnvironmenter is an environment that can safely be shared by objects
+// that are controlled from separate goroutines.
+//
+// In addition to the behavior of a VacancyChecker, an AtomicEnvironmenter
+// guarantees that checking that a position is vacant and occupying that
+// position is a single atomic operation, so that two objects cannot both
+// observe that a position is vacant and then both occupy it.
+//
+// Unsynchronized environments (such as a plateau) can satisfy VacancyChecker,
+// but must not satisfy AtomicEnvironmenter.
+type AtomicEnvironmenter interface {
+	VacancyChecker
+
+	// Atomically calls fn with exclusive access to the environment, so that
+	// a series of calls made by fn is not interleaved with calls from other
+	// goroutines. Within fn, the environment must only be used via the
+	// Environmenter supplied to fn. Atomically returns the error returned by
+	// fn.
+	Atomically(fn func(Environmenter) error) error
+}
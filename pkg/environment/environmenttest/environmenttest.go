@@ -0,0 +1,493 @@
+// Package environmenttest provides a suite of tests that any implementation
+// of the environment contracts (see environmentiface) can run, to prove that
+// it honors those contracts.
+//
+// For example:
+//
+//	func Test_Conformance(t *testing.T) {
+//		environmenttest.TestEnvironmenter(t, func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+//			return NewMyEnvironment(b)
+//		})
+//	}
+//
+// Environments whose edges wrap around (such as environment.Torus) run the
+// suites with the Wrapping option.
+package environmenttest
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// An EnvironmentConstructor constructs the environment under test, spanning
+// the supplied bounds. Each test constructs a new environment.
+type EnvironmentConstructor func(spatial.Rectangle) (environmentiface.Environmenter, error)
+
+// An Option configures the expectations of a suite.
+type Option func(*suite)
+
+// suite holds the expectations of a suite.
+type suite struct {
+	wrapping bool
+}
+
+// Wrapping configures a suite for environments whose edges wrap around. Such
+// environments are expected to normalize positions outside of their bounds
+// back into their bounds, so (Max.X+1, y) refers to the same position as
+// (Min.X, y), rather than rejecting those positions.
+func Wrapping() Option {
+	return func(s *suite) {
+		s.wrapping = true
+	}
+}
+
+func newSuite(options []Option) *suite {
+	s := new(suite)
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// testObject is a minimal Objecter, which is identified by its value.
+type testObject string
+
+func (o testObject) ID() string {
+	return string(o)
+}
+
+// bounds are the bounds of each environment under test. The bounds straddle
+// the origin, so that environments are tested within negative space.
+var bounds = spatial.NewRectangle(spatial.NewPoint(-2, -3), spatial.NewPoint(4, 5))
+
+// wrappedPositions maps positions just outside of the bounds to the positions
+// within the bounds that they refer to, in an environment that wraps around.
+var wrappedPositions = map[spatial.Point]spatial.Point{
+	spatial.NewPoint(bounds.Min.X-1, 0): spatial.NewPoint(bounds.Max.X, 0),
+	spatial.NewPoint(bounds.Max.X+1, 0): spatial.NewPoint(bounds.Min.X, 0),
+	spatial.NewPoint(0, bounds.Min.Y-1): spatial.NewPoint(0, bounds.Max.Y),
+	spatial.NewPoint(0, bounds.Max.Y+1): spatial.NewPoint(0, bounds.Min.Y),
+}
+
+// contenders is the number of goroutines that race one another in the
+// concurrency tests.
+const contenders = 32
+
+// newEnvironment constructs an environment for a test, and fails the test if
+// the environment cannot be constructed.
+func newEnvironment(t *testing.T, construct EnvironmentConstructor) environmentiface.Environmenter {
+	t.Helper()
+	env, err := construct(bounds)
+	if err != nil {
+		t.Fatalf("constructing an environment spanning from '%v' to '%v': %v", bounds.Min, bounds.Max, err)
+	}
+	return env
+}
+
+// TestEnvironmenter runs a suite of tests that verify that the environments
+// built by construct honor the Environmenter contract (see
+// environmentiface.Environmenter). Each rule of the contract is run as a
+// subtest of t.
+//
+// The suite expects positions outside of an environment's bounds to be
+// rejected, unless the Wrapping option is supplied.
+func TestEnvironmenter(t *testing.T, construct EnvironmentConstructor, options ...Option) {
+	suite := newSuite(options)
+	newEnv := func(t *testing.T) environmentiface.Environmenter {
+		t.Helper()
+		return newEnvironment(t, construct)
+	}
+
+	t.Run("reports its bounds", func(t *testing.T) {
+		env := newEnv(t)
+		assert.Equal(t, bounds, env.GetBounds())
+		assert.Equal(t, bounds.Max, env.GetDimensions())
+	})
+
+	t.Run("vacant positions", func(t *testing.T) {
+		env := newEnv(t)
+		for _, position := range []spatial.Point{bounds.Min, bounds.Max, {}} {
+			occupied, objects, err := env.InspectPosition(position)
+			assert.False(t, occupied, "position %v", position)
+			assert.Empty(t, objects, "position %v", position)
+			assert.NoError(t, err, "position %v", position)
+		}
+		assert.Empty(t, env.ShowObjects())
+	})
+
+	t.Run("positions outside of the bounds", func(t *testing.T) {
+		env := newEnv(t)
+		if suite.wrapping {
+			for position, wrappedPosition := range wrappedPositions {
+				object := testObject(fmt.Sprint(position))
+				assert.NoError(t, env.PlaceObject(object, position), "position %v", position)
+
+				found, objectPosition := env.FindObject(object)
+				assert.True(t, found, "position %v", position)
+				assert.Equal(t, wrappedPosition, objectPosition.Position, "position %v", position)
+
+				occupied, objects, err := env.InspectPosition(position)
+				assert.True(t, occupied, "position %v", position)
+				assert.Equal(t, []objectiface.Objecter{object}, objects, "position %v", position)
+				assert.NoError(t, err, "position %v", position)
+			}
+			assert.Len(t, env.ShowObjects(), len(wrappedPositions))
+			return
+		}
+
+		for position := range wrappedPositions {
+			occupied, objects, err := env.InspectPosition(position)
+			assert.False(t, occupied, "position %v", position)
+			assert.Nil(t, objects, "position %v", position)
+			assert.Error(t, err, "position %v", position)
+
+			assert.Error(t, env.PlaceObject(testObject("outside"), position), "position %v", position)
+		}
+		assert.Empty(t, env.ShowObjects())
+	})
+
+	t.Run("placed objects can be found and inspected", func(t *testing.T) {
+		env := newEnv(t)
+		placements := map[spatial.Point][]objectiface.Objecter{
+			bounds.Min:             {testObject("a")},
+			bounds.Max:             {testObject("b")},
+			spatial.NewPoint(0, 0): {testObject("c"), testObject("d")},
+		}
+		for position, objects := range placements {
+			for _, object := range objects {
+				assert.NoError(t, env.PlaceObject(object, position))
+			}
+		}
+
+		for position, objects := range placements {
+			occupied, actObjects, err := env.InspectPosition(position)
+			assert.True(t, occupied, "position %v", position)
+			assert.ElementsMatch(t, objects, actObjects, "position %v", position)
+			assert.NoError(t, err, "position %v", position)
+
+			for _, object := range objects {
+				found, objectPosition := env.FindObject(object)
+				assert.True(t, found, "object %v", object.ID())
+				assert.Equal(t, position, objectPosition.Position)
+				assert.Equal(t, object.ID(), objectPosition.Object.ID())
+			}
+		}
+
+		actPlacements := env.ShowObjects()
+		assert.Len(t, actPlacements, len(placements))
+		for position, objects := range placements {
+			assert.ElementsMatch(t, objects, actPlacements[position], "position %v", position)
+		}
+	})
+
+	t.Run("object IDs are unique", func(t *testing.T) {
+		env := newEnv(t)
+		assert.NoError(t, env.PlaceObject(testObject("a"), spatial.NewPoint(0, 0)))
+		assert.Error(t, env.PlaceObject(testObject("a"), spatial.NewPoint(1, 1)))
+
+		found, objectPosition := env.FindObject(testObject("a"))
+		assert.True(t, found)
+		assert.Equal(t, spatial.NewPoint(0, 0), objectPosition.Position)
+	})
+
+	t.Run("unknown objects cannot be found", func(t *testing.T) {
+		env := newEnv(t)
+		found, _ := env.FindObject(testObject("a"))
+		assert.False(t, found)
+	})
+
+	t.Run("movements are recorded", func(t *testing.T) {
+		env := newEnv(t)
+		object := testObject("a")
+		assert.NoError(t, env.PlaceObject(object, bounds.Min))
+		assert.NoError(t, env.RecordMovement(object, bounds.Max))
+
+		found, objectPosition := env.FindObject(object)
+		assert.True(t, found)
+		assert.Equal(t, bounds.Max, objectPosition.Position)
+
+		occupied, _, err := env.InspectPosition(bounds.Min)
+		assert.False(t, occupied)
+		assert.NoError(t, err)
+	})
+
+	t.Run("movements outside of the bounds", func(t *testing.T) {
+		env := newEnv(t)
+		object := testObject("a")
+		assert.NoError(t, env.PlaceObject(object, bounds.Max))
+		err := env.RecordMovement(object, spatial.NewPoint(bounds.Max.X+1, bounds.Max.Y))
+
+		expPosition := bounds.Max
+		if suite.wrapping {
+			assert.NoError(t, err)
+			expPosition = spatial.NewPoint(bounds.Min.X, bounds.Max.Y)
+		} else {
+			assert.Error(t, err)
+		}
+		found, objectPosition := env.FindObject(object)
+		assert.True(t, found)
+		assert.Equal(t, expPosition, objectPosition.Position)
+	})
+
+	t.Run("movements of unknown objects are rejected", func(t *testing.T) {
+		env := newEnv(t)
+		assert.Error(t, env.RecordMovement(testObject("a"), spatial.NewPoint(0, 0)))
+		assert.Empty(t, env.ShowObjects())
+	})
+
+	t.Run("removed objects no longer occupy a position", func(t *testing.T) {
+		env := newEnv(t)
+		object := testObject("a")
+		assert.NoError(t, env.PlaceObject(object, spatial.NewPoint(0, 0)))
+		assert.NoError(t, env.RemoveObject(object))
+
+		found, _ := env.FindObject(object)
+		assert.False(t, found)
+		occupied, _, err := env.InspectPosition(spatial.NewPoint(0, 0))
+		assert.False(t, occupied)
+		assert.NoError(t, err)
+
+		assert.Error(t, env.RemoveObject(object))
+	})
+}
+
+// A VacancyCheckerConstructor constructs the vacancy checking environment under
+// test, spanning the supplied bounds. Each test constructs a new environment.
+type VacancyCheckerConstructor func(spatial.Rectangle) (environmentiface.VacancyChecker, error)
+
+// An AtomicEnvironmentConstructor constructs the atomic environment under
+// test, spanning the supplied bounds. Each test constructs a new environment.
+type AtomicEnvironmentConstructor func(spatial.Rectangle) (environmentiface.AtomicEnvironmenter, error)
+
+// TestAtomicEnvironmenter runs a suite of tests that verify that the
+// environments built by construct honor the AtomicEnvironmenter contract
+// (see environmentiface.AtomicEnvironmenter), including the VacancyChecker
+// contract (see TestVacancyChecker). The suite races many goroutines against
+// one another, so it is best run with the race detector enabled.
+func TestAtomicEnvironmenter(t *testing.T, construct AtomicEnvironmentConstructor, options ...Option) {
+	TestVacancyChecker(t, func(b spatial.Rectangle) (environmentiface.VacancyChecker, error) {
+		return construct(b)
+	}, options...)
+
+	newEnv := func(t *testing.T) environmentiface.AtomicEnvironmenter {
+		t.Helper()
+		return newEnvironment(t, func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			return construct(b)
+		}).(environmentiface.AtomicEnvironmenter)
+	}
+
+	t.Run("concurrent placements at a vacant position have a single winner", func(t *testing.T) {
+		env := newEnv(t)
+		position := spatial.NewPoint(0, 0)
+		winners := race(func(object testObject) bool {
+			placed, _, err := env.PlaceObjectIfVacant(object, position)
+			assert.NoError(t, err)
+			return placed
+		})
+		assert.Len(t, winners, 1)
+
+		occupied, objects, err := env.InspectPosition(position)
+		assert.True(t, occupied)
+		assert.ElementsMatch(t, winners, objects)
+		assert.NoError(t, err)
+		assert.Len(t, env.ShowObjects(), 1)
+	})
+
+	t.Run("concurrent moves to a vacant position have a single winner", func(t *testing.T) {
+		env := newEnv(t)
+		position := spatial.NewPoint(0, 0)
+
+		// Each contender starts from its own position, away from the
+		// position that the contenders race to.
+		startPositions := make(map[testObject]spatial.Point, contenders)
+		for y := bounds.Min.Y; y <= bounds.Max.Y && len(startPositions) < contenders; y++ {
+			for x := bounds.Min.X; x <= bounds.Max.X && len(startPositions) < contenders; x++ {
+				start := spatial.NewPoint(x, y)
+				if start == position {
+					continue
+				}
+				object := contender(len(startPositions))
+				assert.NoError(t, env.PlaceObject(object, start))
+				startPositions[object] = start
+			}
+		}
+
+		winners := race(func(object testObject) bool {
+			moved, _, err := env.MoveObjectIfVacant(object, position)
+			assert.NoError(t, err)
+			return moved
+		})
+		assert.Len(t, winners, 1)
+
+		occupied, objects, err := env.InspectPosition(position)
+		assert.True(t, occupied)
+		assert.ElementsMatch(t, winners, objects)
+		assert.NoError(t, err)
+		for object, start := range startPositions {
+			if len(winners) == 1 && object == winners[0] {
+				continue
+			}
+			found, objectPosition := env.FindObject(object)
+			assert.True(t, found, "object %v", object.ID())
+			assert.Equal(t, start, objectPosition.Position, "object %v", object.ID())
+		}
+	})
+}
+
+// contender returns the object raced by the ith contender.
+func contender(i int) testObject {
+	return testObject(fmt.Sprintf("contender-%v", i))
+}
+
+// race calls attempt from many goroutines at once, each with its own
+// contender, and returns the contenders for which attempt returned true.
+func race(attempt func(testObject) bool) []objectiface.Objecter {
+	var (
+		start   = make(chan struct{})
+		mu      sync.Mutex
+		winners []objectiface.Objecter
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < contenders; i++ {
+		wg.Add(1)
+		go func(object testObject) {
+			defer wg.Done()
+			<-start
+			if attempt(object) {
+				mu.Lock()
+				winners = append(winners, object)
+				mu.Unlock()
+			}
+		}(contender(i))
+	}
+	close(start)
+	wg.Wait()
+	return winners
+}
+
+// TestVacancyChecker runs a suite of tests that verify that the environments
+// built by construct honor the VacancyChecker contract (see
+// environmentiface.VacancyChecker), including the Environmenter contract (see
+// TestEnvironmenter).
+func TestVacancyChecker(t *testing.T, construct VacancyCheckerConstructor, options ...Option) {
+	TestEnvironmenter(t, func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+		return construct(b)
+	}, options...)
+
+	suite := newSuite(options)
+
+	newEnv := func(t *testing.T) environmentiface.VacancyChecker {
+		t.Helper()
+		return newEnvironment(t, func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			return construct(b)
+		}).(environmentiface.VacancyChecker)
+	}
+
+	t.Run("objects are placed at vacant positions", func(t *testing.T) {
+		env := newEnv(t)
+		placed, occupants, err := env.PlaceObjectIfVacant(testObject("a"), spatial.NewPoint(0, 0))
+		assert.True(t, placed)
+		assert.Nil(t, occupants)
+		assert.NoError(t, err)
+
+		found, objectPosition := env.FindObject(testObject("a"))
+		assert.True(t, found)
+		assert.Equal(t, spatial.NewPoint(0, 0), objectPosition.Position)
+	})
+
+	t.Run("objects are not placed at occupied positions", func(t *testing.T) {
+		env := newEnv(t)
+		assert.NoError(t, env.PlaceObject(testObject("a"), spatial.NewPoint(0, 0)))
+
+		placed, occupants, err := env.PlaceObjectIfVacant(testObject("b"), spatial.NewPoint(0, 0))
+		assert.False(t, placed)
+		assert.ElementsMatch(t, []objectiface.Objecter{testObject("a")}, occupants)
+		assert.NoError(t, err)
+
+		found, _ := env.FindObject(testObject("b"))
+		assert.False(t, found)
+	})
+
+	t.Run("objects placed outside of the bounds", func(t *testing.T) {
+		env := newEnv(t)
+		if suite.wrapping {
+			// The position wraps around to (Min.X, 0), which is occupied.
+			assert.NoError(t, env.PlaceObject(testObject("a"), spatial.NewPoint(bounds.Min.X, 0)))
+			placed, occupants, err := env.PlaceObjectIfVacant(testObject("b"), spatial.NewPoint(bounds.Max.X+1, 0))
+			assert.False(t, placed)
+			assert.ElementsMatch(t, []objectiface.Objecter{testObject("a")}, occupants)
+			assert.NoError(t, err)
+
+			placed, occupants, err = env.PlaceObjectIfVacant(testObject("b"), spatial.NewPoint(bounds.Max.X+1, 1))
+			assert.True(t, placed)
+			assert.Nil(t, occupants)
+			assert.NoError(t, err)
+			found, objectPosition := env.FindObject(testObject("b"))
+			assert.True(t, found)
+			assert.Equal(t, spatial.NewPoint(bounds.Min.X, 1), objectPosition.Position)
+			return
+		}
+
+		placed, occupants, err := env.PlaceObjectIfVacant(testObject("a"), spatial.NewPoint(bounds.Max.X+1, 0))
+		assert.False(t, placed)
+		assert.Nil(t, occupants)
+		assert.Error(t, err)
+	})
+
+	t.Run("objects move to vacant positions", func(t *testing.T) {
+		env := newEnv(t)
+		assert.NoError(t, env.PlaceObject(testObject("a"), spatial.NewPoint(0, 0)))
+
+		moved, occupants, err := env.MoveObjectIfVacant(testObject("a"), spatial.NewPoint(0, 1))
+		assert.True(t, moved)
+		assert.Nil(t, occupants)
+		assert.NoError(t, err)
+
+		found, objectPosition := env.FindObject(testObject("a"))
+		assert.True(t, found)
+		assert.Equal(t, spatial.NewPoint(0, 1), objectPosition.Position)
+	})
+
+	t.Run("objects do not move to occupied positions", func(t *testing.T) {
+		env := newEnv(t)
+		assert.NoError(t, env.PlaceObject(testObject("a"), spatial.NewPoint(0, 0)))
+		assert.NoError(t, env.PlaceObject(testObject("b"), spatial.NewPoint(0, 1)))
+
+		moved, occupants, err := env.MoveObjectIfVacant(testObject("a"), spatial.NewPoint(0, 1))
+		assert.False(t, moved)
+		assert.ElementsMatch(t, []objectiface.Objecter{testObject("b")}, occupants)
+		assert.NoError(t, err)
+
+		found, objectPosition := env.FindObject(testObject("a"))
+		assert.True(t, found)
+		assert.Equal(t, spatial.NewPoint(0, 0), objectPosition.Position)
+	})
+
+	t.Run("objects moved outside of the bounds", func(t *testing.T) {
+		env := newEnv(t)
+		assert.NoError(t, env.PlaceObject(testObject("a"), bounds.Max))
+
+		moved, occupants, err := env.MoveObjectIfVacant(testObject("a"), spatial.NewPoint(bounds.Max.X, bounds.Max.Y+1))
+		assert.Nil(t, occupants)
+
+		expPosition := bounds.Max
+		if suite.wrapping {
+			assert.True(t, moved)
+			assert.NoError(t, err)
+			expPosition = spatial.NewPoint(bounds.Max.X, bounds.Min.Y)
+		} else {
+			assert.False(t, moved)
+			assert.Error(t, err)
+		}
+		found, objectPosition := env.FindObject(testObject("a"))
+		assert.True(t, found)
+		assert.Equal(t, expPosition, objectPosition.Position)
+	})
+}
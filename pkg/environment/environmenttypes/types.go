@@ -12,3 +12,65 @@ type ObjectPosition struct {
 	Object   objectiface.Objecter
 	Position spatial.Point
 }
+
+// EventKind describes what happened within an environment.
+type EventKind int
+
+// Kinds of events that an environment can report.
+const (
+	// EventObjectPlaced reports that an object was placed within the
+	// environment.
+	EventObjectPlaced EventKind = iota
+
+	// EventObjectMoved reports that an object moved from one position within
+	// the environment to another.
+	EventObjectMoved
+
+	// EventMoveRejected reports that the environment refused to record the
+	// movement of an object (such as a move outside of the environment, or a
+	// move into an occupied position that was checked for vacancy).
+	EventMoveRejected
+
+	// EventObjectRemoved reports that an object was taken out of the
+	// environment.
+	EventObjectRemoved
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventObjectPlaced:
+		return "placed"
+	case EventObjectMoved:
+		return "moved"
+	case EventMoveRejected:
+		return "move rejected"
+	case EventObjectRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// An Event describes something that happened to an object within an
+// environment.
+type Event struct {
+	Kind   EventKind
+	Object objectiface.Objecter
+
+	// From is the object's position before the event. From is not set for
+	// EventObjectPlaced, nor for an EventMoveRejected if the object was not
+	// present within the environment.
+	From spatial.Point
+
+	// To is the object's position after the event (or, for an
+	// EventMoveRejected, the position that the object attempted to move to).
+	// To is not set for EventObjectRemoved.
+	To spatial.Point
+
+	// Err is the reason that a move was rejected. Err is only set for
+	// EventMoveRejected.
+	Err error
+}
+
+// A Listener receives the events that occur within an environment.
+type Listener func(Event)
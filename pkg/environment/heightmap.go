@@ -0,0 +1,126 @@
+package environment
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// A HeightMap describes the elevation of each position within an
+// environment.
+//
+// A height map is a rectangular grid of elevations. When attached to an
+// environment (see Plateau.SetHeightMap), the bottom-left cell of the grid
+// describes the bottom-left corner of the environment's bounds.
+type HeightMap struct {
+	// rows are ordered from the bottom of the grid (the lowest Y coordinate)
+	// to the top.
+	rows [][]int
+}
+
+// NewHeightMap instantiates a new HeightMap from a grid of elevations, and
+// returns a reference to that instance.
+//
+// The rows of the grid are ordered as they would appear on a map, so the first
+// row describes the top (north) edge of the environment, and the last row
+// describes the bottom (south) edge. Within each row, the first value
+// describes the left (west) edge of the environment.
+//
+// An *InvalidHeightMapError is returned if the grid is empty, or if the rows
+// are not all the same length.
+func (HeightMap) NewHeightMap(grid [][]int) (*HeightMap, error) {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return nil, ErrInvalidHeightMap(0, "the height map is empty")
+	}
+
+	rows := make([][]int, 0, len(grid))
+	for i := len(grid) - 1; i >= 0; i-- {
+		if len(grid[i]) != len(grid[0]) {
+			return nil, ErrInvalidHeightMap(i+1, "each row must contain the same number of elevations")
+		}
+		rows = append(rows, append([]int(nil), grid[i]...))
+	}
+	return &HeightMap{rows: rows}, nil
+}
+
+// LoadHeightMap reads a height map from a grid file, and returns a reference
+// to the resulting HeightMap.
+//
+// Each line of the file is a row of the grid, and contains whitespace
+// delimited integer elevations. The rows are ordered as described by
+// NewHeightMap. Blank lines are ignored, as is anything following a '#', so
+// grid files can be annotated.
+//
+// An *InvalidHeightMapError is returned if the file is not a valid grid.
+func (HeightMap) LoadHeightMap(r io.Reader) (*HeightMap, error) {
+	grid, _, err := readGrid(r, ErrInvalidHeightMap, "elevation")
+	if err != nil {
+		return nil, err
+	}
+	return HeightMap{}.NewHeightMap(grid)
+}
+
+// readGrid reads a grid file of whitespace delimited integers, ignoring blank
+// lines and anything following a '#'. Problems with the file are reported via
+// invalid (which receives the line of the file at which the problem was found)
+// and describe each value as a valueName. Alongside the grid, readGrid returns
+// the line of the file from which each row of the grid was read.
+func readGrid(r io.Reader, invalid func(line int, reason string) error, valueName string) ([][]int, []int, error) {
+	grid := [][]int{}
+	lines := []int{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		row := make([]int, 0, len(fields))
+		for _, field := range fields {
+			value, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, nil, invalid(line, "'"+field+"' is not an integer "+valueName)
+			}
+			row = append(row, value)
+		}
+		grid = append(grid, row)
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for i, row := range grid {
+		if len(row) != len(grid[0]) {
+			return nil, nil, invalid(lines[i], "each row must contain the same number of "+valueName+"s")
+		}
+	}
+	return grid, lines, nil
+}
+
+// Width returns the number of columns within the height map.
+func (h *HeightMap) Width() int {
+	return len(h.rows[0])
+}
+
+// Height returns the number of rows within the height map.
+func (h *HeightMap) Height() int {
+	return len(h.rows)
+}
+
+// elevation returns the elevation of the cell at the specified column and row,
+// where (0,0) is the bottom-left cell of the grid.
+func (h *HeightMap) elevation(offset spatial.Point) int {
+	return h.rows[offset.Y][offset.X]
+}
@@ -0,0 +1,115 @@
+package environment_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_LoadHeightMap(t *testing.T) {
+	t.Run("a valid grid file", func(t *testing.T) {
+		heightMap, err := environment.HeightMap{}.LoadHeightMap(strings.NewReader(
+			"# the northern ridge\n" +
+				"5 6  7\n" +
+				"\n" +
+				"2\t3 4 # the middle\n" +
+				"-1 0 1\r\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, heightMap.Width())
+		assert.Equal(t, 3, heightMap.Height())
+
+		p := newPlateau(spatial.NewPoint(2, 2))
+		assert.NoError(t, p.SetHeightMap(heightMap))
+		expElevations := map[spatial.Point]int{
+			{X: 0, Y: 0}: -1,
+			{X: 2, Y: 0}: 1,
+			{X: 1, Y: 1}: 3,
+			{X: 0, Y: 2}: 5,
+			{X: 2, Y: 2}: 7,
+		}
+		for position, expElevation := range expElevations {
+			elevation, err := p.Elevation(position)
+			assert.NoError(t, err)
+			assert.Equal(t, expElevation, elevation, "position %v", position)
+		}
+	})
+
+	t.Run("invalid grid files", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			grid   string
+			expErr error
+		}{
+			{"empty", "# nothing here\n", environment.ErrInvalidHeightMap(0, "the height map is empty")},
+			{"not an integer", "1 2\n3 x\n", environment.ErrInvalidHeightMap(2, "'x' is not an integer elevation")},
+			{"ragged rows", "1 2\n\n3 4 5\n", environment.ErrInvalidHeightMap(3, "each row must contain the same number of elevations")},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				heightMap, err := environment.HeightMap{}.LoadHeightMap(strings.NewReader(testCase.grid))
+				assert.Nil(t, heightMap)
+				assert.EqualError(t, err, testCase.expErr.Error())
+
+				var invalidHeightMap *environment.InvalidHeightMapError
+				assert.True(t, errors.As(err, &invalidHeightMap))
+			})
+		}
+	})
+}
+
+func Test_PlateauElevation(t *testing.T) {
+	t.Run("a plateau without a height map is flat", func(t *testing.T) {
+		p := newPlateau(spatial.NewPoint(5, 5))
+		elevation, err := p.Elevation(spatial.NewPoint(3, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, elevation)
+	})
+
+	t.Run("positions outside of the plateau return an error", func(t *testing.T) {
+		p := newPlateau(spatial.NewPoint(5, 5))
+		_, err := p.Elevation(spatial.NewPoint(6, 3))
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(6, 3)).Error())
+	})
+
+	t.Run("height maps are relative to the bounds of the plateau", func(t *testing.T) {
+		heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{{3, 4}, {1, 2}})
+		assert.NoError(t, err)
+
+		p, err := environment.Plateau{}.NewBoundedPlateau(
+			spatial.NewRectangle(spatial.NewPoint(-1, -1), spatial.NewPoint(0, 0)))
+		assert.NoError(t, err)
+		assert.NoError(t, p.SetHeightMap(heightMap))
+
+		elevation, err := p.Elevation(spatial.NewPoint(-1, -1))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, elevation)
+		elevation, err = p.Elevation(spatial.NewPoint(0, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, 4, elevation)
+	})
+
+	t.Run("a height map must match the bounds of the plateau", func(t *testing.T) {
+		heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{{3, 4}, {1, 2}})
+		assert.NoError(t, err)
+
+		p := newPlateau(spatial.NewPoint(2, 1))
+		err = p.SetHeightMap(heightMap)
+		assert.EqualError(t, err, environment.ErrHeightMapMismatch(2, 2, p.GetBounds()).Error())
+	})
+
+	t.Run("a torus normalizes the position", func(t *testing.T) {
+		heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{{3, 4}, {1, 2}})
+		assert.NoError(t, err)
+
+		torus := newTorus(spatial.NewPoint(1, 1))
+		assert.NoError(t, torus.SetHeightMap(heightMap))
+		elevation, err := torus.Elevation(spatial.NewPoint(-1, 2))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, elevation)
+	})
+}
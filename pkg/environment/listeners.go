@@ -0,0 +1,37 @@
+package environment
+
+import (
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttypes"
+)
+
+// A subscription is a single registered listener. Subscriptions are compared
+// by reference, so that the same listener can be registered more than once.
+type subscription struct {
+	listener environmenttypes.Listener
+}
+
+// listeners is an ordered list of the subscriptions to an environment's events.
+type listeners []*subscription
+
+// subscribe registers a listener, and returns a function that removes it.
+func (l *listeners) subscribe(listener environmenttypes.Listener) func() {
+	sub := &subscription{listener: listener}
+	*l = append(*l, sub)
+	return func() {
+		remaining := listeners{}
+		for _, existing := range *l {
+			if existing != sub {
+				remaining = append(remaining, existing)
+			}
+		}
+		*l = remaining
+	}
+}
+
+// emit delivers an event to each listener, in the order that the listeners
+// were registered.
+func (l listeners) emit(event environmenttypes.Event) {
+	for _, sub := range l {
+		sub.listener(event)
+	}
+}
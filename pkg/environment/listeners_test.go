@@ -0,0 +1,91 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttypes"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_PlateauSubscribe(t *testing.T) {
+	p := newPlateau(spatial.NewPoint(5, 5))
+	rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+
+	first := []environmenttypes.Event{}
+	unsubscribeFirst := p.Subscribe(func(event environmenttypes.Event) {
+		first = append(first, event)
+	})
+	second := []environmenttypes.Event{}
+	p.Subscribe(func(event environmenttypes.Event) {
+		second = append(second, event)
+	})
+
+	assert.NoError(t, p.PlaceObject(rock, spatial.NewPoint(1, 1)))
+	assert.NoError(t, p.RecordMovement(rock, spatial.NewPoint(1, 2)))
+	outsideErr := p.RecordMovement(rock, spatial.NewPoint(1, 6))
+	assert.Error(t, outsideErr)
+
+	unsubscribeFirst()
+	assert.NoError(t, p.RecordMovement(rock, spatial.NewPoint(1, 3)))
+
+	missing := environment.Obstacle{}.NewObstacle(environment.ObstacleCrater)
+	missingErr := p.RecordMovement(missing, spatial.NewPoint(2, 2))
+	assert.Error(t, missingErr)
+	assert.NoError(t, p.RemoveObject(rock))
+
+	expEvents := []environmenttypes.Event{
+		{Kind: environmenttypes.EventObjectPlaced, Object: rock, To: spatial.NewPoint(1, 1)},
+		{Kind: environmenttypes.EventObjectMoved, Object: rock, From: spatial.NewPoint(1, 1), To: spatial.NewPoint(1, 2)},
+		{Kind: environmenttypes.EventMoveRejected, Object: rock, From: spatial.NewPoint(1, 2), To: spatial.NewPoint(1, 6), Err: outsideErr},
+		{Kind: environmenttypes.EventObjectMoved, Object: rock, From: spatial.NewPoint(1, 2), To: spatial.NewPoint(1, 3)},
+		{Kind: environmenttypes.EventMoveRejected, Object: missing, To: spatial.NewPoint(2, 2), Err: missingErr},
+		{Kind: environmenttypes.EventObjectRemoved, Object: rock, From: spatial.NewPoint(1, 3)},
+	}
+	assert.Equal(t, expEvents[:3], first)
+	assert.Equal(t, expEvents, second)
+}
+
+func Test_WrappedEnvironmentSubscribe(t *testing.T) {
+	testCases := []struct {
+		name        string
+		env         environmentiface.Environmenter
+		expPosition spatial.Point
+	}{
+		{
+			name:        "torus events refer to normalized positions",
+			env:         newTorus(spatial.NewPoint(5, 5)),
+			expPosition: spatial.NewPoint(0, 1),
+		},
+		{
+			name:        "sync environment events come from the wrapped environment",
+			env:         environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(6, 6))),
+			expPosition: spatial.NewPoint(6, 1),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			events := []environmenttypes.Event{}
+			testCase.env.(environmentiface.Observable).Subscribe(func(event environmenttypes.Event) {
+				events = append(events, event)
+			})
+
+			rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+			assert.NoError(t, testCase.env.PlaceObject(rock, spatial.NewPoint(6, 1)))
+			assert.Equal(t, []environmenttypes.Event{
+				{Kind: environmenttypes.EventObjectPlaced, Object: rock, To: testCase.expPosition},
+			}, events)
+		})
+	}
+}
+
+func Test_EventKindString(t *testing.T) {
+	assert.Equal(t, "placed", environmenttypes.EventObjectPlaced.String())
+	assert.Equal(t, "moved", environmenttypes.EventObjectMoved.String())
+	assert.Equal(t, "move rejected", environmenttypes.EventMoveRejected.String())
+	assert.Equal(t, "removed", environmenttypes.EventObjectRemoved.String())
+	assert.Equal(t, "unknown", environmenttypes.EventKind(-1).String())
+}
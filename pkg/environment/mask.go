@@ -0,0 +1,170 @@
+package environment
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// A Mask describes the shape of an environment whose legal area is not
+// rectangular. When attached to an environment (see Plateau.SetMask), only the
+// positions within the mask are considered to be part of the environment.
+//
+// A mask is either a grid of traversable and untraversable cells (see
+// NewGridMask), or a polygon (see NewPolygonMask).
+type Mask struct {
+	// cells are ordered from the bottom of the grid (the lowest Y coordinate)
+	// to the top. cells is nil if the mask is a polygon.
+	cells [][]bool
+
+	// vertices are the corners of the polygon, in order. vertices is nil if
+	// the mask is a grid.
+	vertices []spatial.Point
+}
+
+// NewGridMask instantiates a new Mask from a grid of cells, and returns a
+// reference to that instance. Each cell is true if its position is
+// traversable.
+//
+// The rows of the grid are ordered as they would appear on a map, so the first
+// row describes the top (north) edge of the environment, and the last row
+// describes the bottom (south) edge. Within each row, the first cell
+// describes the left (west) edge of the environment. When attached to an
+// environment, the bottom-left cell of the grid describes the bottom-left
+// corner of the environment's bounds.
+//
+// An *InvalidMaskError is returned if the grid is empty, or if the rows are
+// not all the same length.
+func (Mask) NewGridMask(grid [][]bool) (*Mask, error) {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return nil, ErrInvalidMask(0, "the mask is empty")
+	}
+
+	cells := make([][]bool, 0, len(grid))
+	for i := len(grid) - 1; i >= 0; i-- {
+		if len(grid[i]) != len(grid[0]) {
+			return nil, ErrInvalidMask(i+1, "each row must contain the same number of cells")
+		}
+		cells = append(cells, append([]bool(nil), grid[i]...))
+	}
+	return &Mask{cells: cells}, nil
+}
+
+// LoadGridMask reads a grid mask from a grid file, and returns a reference to
+// the resulting Mask.
+//
+// Each line of the file is a row of the grid, and contains whitespace
+// delimited cells, each of which is either 1 (traversable) or 0
+// (untraversable). The rows are ordered as described by NewGridMask. Blank
+// lines are ignored, as is anything following a '#', so grid files can be
+// annotated.
+//
+// An *InvalidMaskError is returned if the file is not a valid grid.
+func (Mask) LoadGridMask(r io.Reader) (*Mask, error) {
+	values, lines, err := readGrid(r, ErrInvalidMask, "cell")
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make([][]bool, 0, len(values))
+	for i, row := range values {
+		cells := make([]bool, 0, len(row))
+		for _, value := range row {
+			if value != 0 && value != 1 {
+				return nil, ErrInvalidMask(lines[i], fmt.Sprintf("'%v' is not a valid cell (expected 0 or 1)", value))
+			}
+			cells = append(cells, value == 1)
+		}
+		grid = append(grid, cells)
+	}
+	return Mask{}.NewGridMask(grid)
+}
+
+// NewPolygonMask instantiates a new Mask from the vertices of a polygon, and
+// returns a reference to that instance. The vertices are absolute positions,
+// listed in order around the polygon (in either direction). Positions that lie
+// within the polygon, or on one of its edges, are traversable.
+//
+// An *InvalidMaskError is returned if fewer than three vertices are supplied.
+func (Mask) NewPolygonMask(vertices []spatial.Point) (*Mask, error) {
+	if len(vertices) < 3 {
+		return nil, ErrInvalidMask(0, "a polygon must have at least three vertices")
+	}
+	return &Mask{vertices: append([]spatial.Point(nil), vertices...)}, nil
+}
+
+// IsGrid returns true if the mask is a grid of cells, or false if the mask is
+// a polygon.
+func (m *Mask) IsGrid() bool {
+	return m.cells != nil
+}
+
+// Width returns the number of columns within a grid mask, or 0 if the mask is
+// a polygon.
+func (m *Mask) Width() int {
+	if !m.IsGrid() {
+		return 0
+	}
+	return len(m.cells[0])
+}
+
+// Height returns the number of rows within a grid mask, or 0 if the mask is a
+// polygon.
+func (m *Mask) Height() int {
+	return len(m.cells)
+}
+
+// contains returns true if a position is traversable. Grid cells are offset
+// from the minimum corner of the supplied bounds, which the position is
+// assumed to lie within.
+func (m *Mask) contains(bounds spatial.Rectangle, position spatial.Point) bool {
+	if m.IsGrid() {
+		return m.cells[position.Y-bounds.Min.Y][position.X-bounds.Min.X]
+	}
+	return m.polygonContains(position)
+}
+
+// polygonContains returns true if a position lies within the polygon, or on
+// one of its edges.
+func (m *Mask) polygonContains(position spatial.Point) bool {
+	inside := false
+	for i := range m.vertices {
+		a := m.vertices[i]
+		b := m.vertices[(i+1)%len(m.vertices)]
+		if onSegment(a, b, position) {
+			return true
+		}
+
+		// Cast a ray from the position towards positive X, and count the
+		// edges that it crosses.
+		if (a.Y > position.Y) != (b.Y > position.Y) {
+			// The X coordinate at which the edge crosses the ray is
+			// a.X + (position.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y). The comparison is
+			// cross-multiplied to avoid dividing.
+			lhs := (position.X - a.X) * (b.Y - a.Y)
+			rhs := (position.Y - a.Y) * (b.X - a.X)
+			if (b.Y > a.Y && lhs < rhs) || (b.Y < a.Y && lhs > rhs) {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// onSegment returns true if p lies on the line segment from a to b.
+func onSegment(a, b, p spatial.Point) bool {
+	cross := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+	if cross != 0 {
+		return false
+	}
+	return between(a.X, b.X, p.X) && between(a.Y, b.Y, p.Y)
+}
+
+// between returns true if v lies between a and b (inclusive), in either order.
+func between(a, b, v int) bool {
+	if a > b {
+		a, b = b, a
+	}
+	return a <= v && v <= b
+}
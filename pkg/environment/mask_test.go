@@ -0,0 +1,172 @@
+package environment_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_LoadGridMask(t *testing.T) {
+	t.Run("a valid grid file", func(t *testing.T) {
+		mask, err := environment.Mask{}.LoadGridMask(strings.NewReader(
+			"# the northern edge\n" +
+				"0 1 0\n" +
+				"\n" +
+				"1 1 1 # the middle\n" +
+				"1 0 1\r\n"))
+		assert.NoError(t, err)
+		assert.True(t, mask.IsGrid())
+		assert.Equal(t, 3, mask.Width())
+		assert.Equal(t, 3, mask.Height())
+
+		p := newPlateau(spatial.NewPoint(2, 2))
+		assert.NoError(t, p.SetMask(mask))
+		expTraversable := map[spatial.Point]bool{
+			{X: 0, Y: 0}: true,
+			{X: 1, Y: 0}: false,
+			{X: 1, Y: 1}: true,
+			{X: 0, Y: 2}: false,
+			{X: 1, Y: 2}: true,
+		}
+		for position, traversable := range expTraversable {
+			_, _, err := p.InspectPosition(position)
+			if traversable {
+				assert.NoError(t, err, "position %v", position)
+			} else {
+				assert.EqualError(t, err, environment.ErrPositionOutsideBounds(position).Error())
+			}
+		}
+	})
+
+	t.Run("invalid grid files", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			grid   string
+			expErr error
+		}{
+			{"empty", "# nothing here\n", environment.ErrInvalidMask(0, "the mask is empty")},
+			{"not an integer", "1 0\n1 x\n", environment.ErrInvalidMask(2, "'x' is not an integer cell")},
+			{"not a cell", "1 0\n\n1 2\n", environment.ErrInvalidMask(3, "'2' is not a valid cell (expected 0 or 1)")},
+			{"ragged rows", "1 0\n1 1 1\n", environment.ErrInvalidMask(2, "each row must contain the same number of cells")},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				mask, err := environment.Mask{}.LoadGridMask(strings.NewReader(testCase.grid))
+				assert.Nil(t, mask)
+				assert.EqualError(t, err, testCase.expErr.Error())
+
+				var invalidMask *environment.InvalidMaskError
+				assert.True(t, errors.As(err, &invalidMask))
+			})
+		}
+	})
+}
+
+func Test_PolygonMask(t *testing.T) {
+	// A diamond centered on (2,2).
+	mask, err := environment.Mask{}.NewPolygonMask([]spatial.Point{
+		spatial.NewPoint(2, 0),
+		spatial.NewPoint(4, 2),
+		spatial.NewPoint(2, 4),
+		spatial.NewPoint(0, 2),
+	})
+	assert.NoError(t, err)
+	assert.False(t, mask.IsGrid())
+
+	p := newPlateau(spatial.NewPoint(4, 4))
+	assert.NoError(t, p.SetMask(mask))
+
+	expTraversable := map[spatial.Point]bool{
+		{X: 2, Y: 2}: true,
+		{X: 2, Y: 0}: true,
+		{X: 3, Y: 1}: true,
+		{X: 0, Y: 2}: true,
+		{X: 1, Y: 2}: true,
+		{X: 2, Y: 3}: true,
+		{X: 0, Y: 0}: false,
+		{X: 4, Y: 4}: false,
+		{X: 3, Y: 0}: false,
+		{X: 0, Y: 3}: false,
+	}
+	for position, traversable := range expTraversable {
+		_, _, err := p.InspectPosition(position)
+		if traversable {
+			assert.NoError(t, err, "position %v", position)
+		} else {
+			assert.EqualError(t, err, environment.ErrPositionOutsideBounds(position).Error(), "position %v", position)
+		}
+	}
+
+	t.Run("too few vertices", func(t *testing.T) {
+		mask, err := environment.Mask{}.NewPolygonMask([]spatial.Point{{}, spatial.NewPoint(1, 1)})
+		assert.Nil(t, mask)
+		assert.EqualError(t, err, environment.ErrInvalidMask(0, "a polygon must have at least three vertices").Error())
+	})
+}
+
+func Test_PlateauMask(t *testing.T) {
+	// An L shaped plateau.
+	newMaskedPlateau := func() *environment.Plateau {
+		mask, err := environment.Mask{}.NewGridMask([][]bool{
+			{true, false},
+			{true, true},
+		})
+		if err != nil {
+			panic(err)
+		}
+		p := newPlateau(spatial.NewPoint(1, 1))
+		if err := p.SetMask(mask); err != nil {
+			panic(err)
+		}
+		return p
+	}
+
+	t.Run("objects cannot be placed outside of the mask", func(t *testing.T) {
+		p := newMaskedPlateau()
+		obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		err := p.PlaceObject(obstacle, spatial.NewPoint(1, 1))
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(1, 1)).Error())
+		assert.NoError(t, p.PlaceObject(obstacle, spatial.NewPoint(0, 1)))
+	})
+
+	t.Run("objects cannot move outside of the mask", func(t *testing.T) {
+		p := newMaskedPlateau()
+		obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		assert.NoError(t, p.PlaceObject(obstacle, spatial.NewPoint(1, 0)))
+
+		err := p.RecordMovement(obstacle, spatial.NewPoint(1, 1))
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(1, 1)).Error())
+		found, objectPosition := p.FindObject(obstacle)
+		assert.True(t, found)
+		assert.Equal(t, spatial.NewPoint(1, 0), objectPosition.Position)
+	})
+
+	t.Run("a grid mask must match the bounds of the plateau", func(t *testing.T) {
+		mask, err := environment.Mask{}.NewGridMask([][]bool{{true, true}})
+		assert.NoError(t, err)
+
+		p := newPlateau(spatial.NewPoint(1, 1))
+		err = p.SetMask(mask)
+		assert.EqualError(t, err, environment.ErrMaskMismatch(2, 1, p.GetBounds()).Error())
+	})
+
+	t.Run("a torus normalizes the position", func(t *testing.T) {
+		mask, err := environment.Mask{}.NewGridMask([][]bool{
+			{true, false},
+			{true, true},
+		})
+		assert.NoError(t, err)
+
+		torus := newTorus(spatial.NewPoint(1, 1))
+		assert.NoError(t, torus.SetMask(mask))
+		_, _, err = torus.InspectPosition(spatial.NewPoint(-1, 1))
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(1, 1)).Error())
+		_, _, err = torus.InspectPosition(spatial.NewPoint(1, 2))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,117 @@
+package environment
+
+import (
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// An objectStore records the objects present at each position within an
+// environment. Positions supplied to an objectStore are assumed to lie within
+// the bounds of the environment.
+type objectStore interface {
+	// objectsAt returns the objects present at a position, or an empty list
+	// if the position is vacant.
+	objectsAt(position spatial.Point) []objectiface.Objecter
+
+	// setObjectsAt replaces the objects present at a position. Supplying an
+	// empty list vacates the position.
+	setObjectsAt(position spatial.Point, objects []objectiface.Objecter)
+
+	// occupied returns a sparse map of the positions that contain objects.
+	// Both the map and the lists within it are copies.
+	occupied() map[spatial.Point][]objectiface.Objecter
+}
+
+// sparseStore is an objectStore that only records the positions that contain
+// objects. It suits large environments that contain relatively few objects.
+type sparseStore map[spatial.Point][]objectiface.Objecter
+
+func (s sparseStore) objectsAt(position spatial.Point) []objectiface.Objecter {
+	return s[position]
+}
+
+func (s sparseStore) setObjectsAt(position spatial.Point, objects []objectiface.Objecter) {
+	if len(objects) == 0 {
+		delete(s, position)
+	} else {
+		s[position] = objects
+	}
+}
+
+func (s sparseStore) occupied() map[spatial.Point][]objectiface.Objecter {
+	return copyObjects(s)
+}
+
+// denseStore is an objectStore that reserves a cell for every position within
+// the environment. It suits environments that are crowded with objects, since
+// each position can be reached without hashing, at the cost of memory for
+// every position (including vacant ones).
+type denseStore struct {
+	bounds spatial.Rectangle
+
+	// cells are ordered by row (from the lowest Y coordinate) and then by
+	// column (from the lowest X coordinate).
+	cells [][]objectiface.Objecter
+
+	// occupiedCells is the number of cells that contain objects.
+	occupiedCells int
+}
+
+func newDenseStore(bounds spatial.Rectangle) *denseStore {
+	return &denseStore{
+		bounds: bounds,
+		cells:  make([][]objectiface.Objecter, bounds.Width()*bounds.Height()),
+	}
+}
+
+func (d *denseStore) objectsAt(position spatial.Point) []objectiface.Objecter {
+	return d.cells[d.cellIndex(position)]
+}
+
+func (d *denseStore) setObjectsAt(position spatial.Point, objects []objectiface.Objecter) {
+	i := d.cellIndex(position)
+	if len(d.cells[i]) == 0 && len(objects) > 0 {
+		d.occupiedCells++
+	} else if len(d.cells[i]) > 0 && len(objects) == 0 {
+		d.occupiedCells--
+	}
+
+	if len(objects) == 0 {
+		d.cells[i] = nil
+	} else {
+		d.cells[i] = objects
+	}
+}
+
+func (d *denseStore) occupied() map[spatial.Point][]objectiface.Objecter {
+	objects := make(map[spatial.Point][]objectiface.Objecter, d.occupiedCells)
+	width := d.bounds.Width()
+	for i := 0; i < len(d.cells) && len(objects) < d.occupiedCells; i++ {
+		if len(d.cells[i]) == 0 {
+			continue
+		}
+		position := spatial.NewPoint(d.bounds.Min.X+i%width, d.bounds.Min.Y+i/width)
+		objects[position] = append([]objectiface.Objecter(nil), d.cells[i]...)
+	}
+	return objects
+}
+
+func (d *denseStore) cellIndex(position spatial.Point) int {
+	return (position.Y-d.bounds.Min.Y)*d.bounds.Width() + (position.X - d.bounds.Min.X)
+}
+
+// copyObjects returns a copy of a sparse map of objects, including a copy of
+// each list of objects within the map.
+func copyObjects(objects map[spatial.Point][]objectiface.Objecter) map[spatial.Point][]objectiface.Objecter {
+	objectsCopy := make(map[spatial.Point][]objectiface.Objecter, len(objects))
+	for position, objectList := range objects {
+		objectsCopy[position] = copyObjectList(objectList)
+	}
+	return objectsCopy
+}
+
+// copyObjectList returns a copy of a list of objects. The copy of an empty list
+// is nil.
+func copyObjectList(objects []objectiface.Objecter) []objectiface.Objecter {
+	return append([]objectiface.Objecter(nil), objects...)
+}
@@ -0,0 +1,72 @@
+package environment
+
+import (
+	"github.com/google/uuid"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+)
+
+// ObstacleKind describes the kind of terrain feature that an obstacle
+// represents.
+type ObstacleKind string
+
+// Kinds of obstacles that can be present within an environment.
+const (
+	ObstacleUnknown ObstacleKind = ""
+	ObstacleRock    ObstacleKind = "rock"
+	ObstacleCrater  ObstacleKind = "crater"
+)
+
+// ObstacleKindFromString converts an obstacle kind string ("rock", "crater")
+// to an ObstacleKind. If the supplied value cannot be mapped to a kind, this
+// function will return ObstacleUnknown.
+func ObstacleKindFromString(k string) ObstacleKind {
+	switch k {
+	case "rock":
+		return ObstacleRock
+	case "crater":
+		return ObstacleCrater
+	default:
+		return ObstacleUnknown
+	}
+}
+
+// An Obstacle is a static terrain feature (such as a rock or a crater) that
+// occupies a position within an environment.
+//
+// Obstacles are placed within an environment like any other object (see
+// PlaceObject), and since they occupy their position, other objects (such as
+// rovers) will treat that position as blocked.
+type Obstacle struct {
+	id   string
+	kind ObstacleKind
+}
+
+// NewObstacle initializes a new obstacle of the specified kind.
+func (Obstacle) NewObstacle(kind ObstacleKind) *Obstacle {
+	return &Obstacle{
+		id:   uuid.New().String(),
+		kind: kind,
+	}
+}
+
+// RestoreObstacle initializes an obstacle with a known ID (such as an obstacle
+// that was previously saved).
+func (Obstacle) RestoreObstacle(id string, kind ObstacleKind) *Obstacle {
+	return &Obstacle{
+		id:   id,
+		kind: kind,
+	}
+}
+
+// ID returns a string that uniquely identifies this Obstacle instance.
+func (o *Obstacle) ID() string {
+	return o.id
+}
+
+// Kind returns the kind of terrain feature that the obstacle represents.
+func (o *Obstacle) Kind() ObstacleKind {
+	return o.kind
+}
+
+// Assert Obstacle implements Objecter
+var _ objectiface.Objecter = (*Obstacle)(nil)
@@ -0,0 +1,42 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ObstacleKindFromString(t *testing.T) {
+	assert.Equal(t, environment.ObstacleRock, environment.ObstacleKindFromString("rock"))
+	assert.Equal(t, environment.ObstacleCrater, environment.ObstacleKindFromString("crater"))
+	assert.Equal(t, environment.ObstacleUnknown, environment.ObstacleKindFromString("lake"))
+}
+
+func Test_NewObstacle(t *testing.T) {
+	rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+	crater := environment.Obstacle{}.NewObstacle(environment.ObstacleCrater)
+
+	assert.Equal(t, environment.ObstacleRock, rock.Kind())
+	assert.Equal(t, environment.ObstacleCrater, crater.Kind())
+	assert.NotEmpty(t, rock.ID())
+	assert.NotEqual(t, rock.ID(), crater.ID())
+}
+
+func Test_PlateauObstacles(t *testing.T) {
+	p := newPlateau(spatial.NewPoint(5, 5))
+	rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+	position := spatial.NewPoint(2, 3)
+	assert.NoError(t, p.PlaceObject(rock, position))
+
+	occupied, objects, err := p.InspectPosition(position)
+	assert.NoError(t, err)
+	assert.True(t, occupied)
+	assert.Equal(t, []objectiface.Objecter{rock}, objects)
+
+	obstacle, ok := p.ShowObjects()[position][0].(*environment.Obstacle)
+	assert.True(t, ok)
+	assert.Equal(t, environment.ObstacleRock, obstacle.Kind())
+}
@@ -7,26 +7,151 @@ import (
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
-type objectStore map[spatial.Point][]objectiface.Objecter
+// objectIndex maps the ID of each object within the environment to the
+// object's position.
+type objectIndex map[string]spatial.Point
 
 // Plateau is a curiously rectangular martian environment.
+//
+// The plateau keeps an index of each object's position alongside its map of
+// positions, so that objects can be found, moved, and removed without
+// searching the entire plateau.
+//
+// The plateau reports each placement and movement (including each rejected
+// movement) to any subscribed listeners (see Subscribe).
+//
+// By default, a plateau only stores the positions that contain objects, which
+// suits large plateaus that contain relatively few objects. Crowded plateaus
+// can instead reserve storage for every position (see DenseStorage and
+// AutoStorage).
+//
+// A plateau is flat (every position has an elevation of 0) unless a height map
+// is attached to it (see SetHeightMap).
+//
+// Despite its name, a plateau need not be rectangular. If a mask is attached to
+// the plateau (see SetMask), only the positions within both the bounds and the
+// mask are part of the plateau.
 type Plateau struct {
-	dimensions spatial.Point
-	objects    objectStore
+	bounds    spatial.Rectangle
+	objects   objectStore
+	index     objectIndex
+	listeners listeners
+	heightMap *HeightMap
+	mask      *Mask
+}
+
+// A PlateauOption configures optional plateau behavior.
+type PlateauOption func(*Plateau)
+
+// DenseStorage configures a plateau to reserve storage for every position
+// within its bounds, rather than only for the positions that contain objects.
+// Dense storage is faster for plateaus that are crowded with objects, but
+// consumes memory in proportion to the area of the plateau.
+//
+// A plateau whose area is too large to be represented as an int keeps sparse
+// storage, since a cell could not be reserved for every position.
+func DenseStorage() PlateauOption {
+	return func(p *Plateau) {
+		if _, ok := area(p.bounds); ok {
+			p.objects = newDenseStore(p.bounds)
+		}
+	}
+}
+
+// AutoStorage configures a plateau to choose its storage based on the area of
+// the plateau, and the number of objects that the plateau is expected to hold.
+// Dense storage (see DenseStorage) is chosen if at least one in every eight
+// positions is expected to contain an object, and the plateau is small enough
+// for dense storage to fit comfortably in memory. Sparse storage is chosen if no
+// objects are expected.
+func AutoStorage(expectedObjects int) PlateauOption {
+	return func(p *Plateau) {
+		if preferDenseStorage(p.bounds, expectedObjects) {
+			DenseStorage()(p)
+		}
+	}
+}
+
+const (
+	// maxDenseArea is the area of the largest plateau that AutoStorage will
+	// configure with dense storage (around 100MB of cells).
+	maxDenseArea = 1 << 22
+
+	// denseOccupancy is the ratio of positions to objects at or below which
+	// AutoStorage prefers dense storage.
+	denseOccupancy = 8
+
+	// maxInt is the largest value that can be represented as an int.
+	maxInt = int(^uint(0) >> 1)
+)
+
+// preferDenseStorage returns true if dense storage suits a plateau with the
+// specified bounds and expected number of objects. Sparse storage is always
+// preferred if no objects are expected.
+func preferDenseStorage(bounds spatial.Rectangle, expectedObjects int) bool {
+	if expectedObjects <= 0 {
+		return false
+	}
+	area, ok := area(bounds)
+	if !ok || area > maxDenseArea {
+		return false
+	}
+	return expectedObjects >= (area+denseOccupancy-1)/denseOccupancy
+}
+
+// area returns the number of positions within the bounds. If the number cannot
+// be represented as an int, false is returned.
+func area(bounds spatial.Rectangle) (int, bool) {
+	width, height := bounds.Width(), bounds.Height()
+	if width <= 0 || height <= 0 || width > maxInt/height {
+		return 0, false
+	}
+	return width * height, true
 }
 
-// NewPlateau instantiates a new Plateau and returns a reference to that
-// instance.
-func (Plateau) NewPlateau(dimensions spatial.Point) *Plateau {
-	return &Plateau{
-		dimensions: dimensions,
-		objects:    make(objectStore),
+// NewPlateau instantiates a new Plateau spanning from (0,0) to the specified
+// dimensions (inclusive), and returns a reference to that instance.
+//
+// An error is returned if either of the dimensions is negative.
+func (Plateau) NewPlateau(dimensions spatial.Point, options ...PlateauOption) (*Plateau, error) {
+	if dimensions.X < 0 || dimensions.Y < 0 {
+		return nil, ErrNegativeDimensions(dimensions)
 	}
+	return Plateau{}.NewBoundedPlateau(spatial.NewRectangle(spatial.Point{}, dimensions), options...)
 }
 
-// GetDimensions returns the dimension of the environment.
+// NewBoundedPlateau instantiates a new Plateau spanning the specified bounds
+// (inclusive), and returns a reference to that instance. The bounds may lie
+// anywhere on the plane, including within negative space.
+//
+// Options (such as DenseStorage) are applied once the plateau is initialized.
+//
+// An error is returned if the bounds are inverted (if the minimum corner
+// exceeds the maximum corner).
+func (Plateau) NewBoundedPlateau(bounds spatial.Rectangle, options ...PlateauOption) (*Plateau, error) {
+	if bounds.Inverted() {
+		return nil, ErrInvertedBounds(bounds)
+	}
+	plateau := &Plateau{
+		bounds:  bounds,
+		objects: make(sparseStore),
+		index:   make(objectIndex),
+	}
+	for _, option := range options {
+		option(plateau)
+	}
+	return plateau, nil
+}
+
+// GetDimensions returns the dimension of the environment, which is the
+// upper-right corner of the environment's bounds.
 func (p *Plateau) GetDimensions() spatial.Point {
-	return p.dimensions
+	return p.bounds.Max
+}
+
+// GetBounds returns the bounds of the environment.
+func (p *Plateau) GetBounds() spatial.Rectangle {
+	return p.bounds
 }
 
 // PlaceObject attempts to insert a new object into the environment at the
@@ -51,7 +176,7 @@ func (p *Plateau) PlaceObject(object objectiface.Objecter, position spatial.Poin
 		return ErrNilObject()
 	}
 
-	err := p.verifyPositionIsLegal(position)
+	err := p.verifyObjectPositionIsLegal(object, position)
 	if err != nil {
 		return err
 	}
@@ -61,49 +186,221 @@ func (p *Plateau) PlaceObject(object objectiface.Objecter, position spatial.Poin
 	}
 
 	p.placeObjectUnchecked(object, position)
+	p.listeners.emit(environmenttypes.Event{
+		Kind:   environmenttypes.EventObjectPlaced,
+		Object: object,
+		To:     position,
+	})
 	return nil
 }
 
 // RecordMovement records the movement of an object from one position in the
 // environment to another.
+//
+// If the movement cannot be recorded (for instance, if the new position is
+// outside of the plateau), an error is returned, and the rejected movement is
+// reported to any subscribed listeners.
 func (p *Plateau) RecordMovement(object objectiface.Objecter, newPosition spatial.Point) error {
 	if object == nil {
 		return ErrNilObject()
 	}
 
-	err := p.verifyPositionIsLegal(newPosition)
+	found, objectPosition := p.FindObject(object)
+	err := p.verifyObjectPositionIsLegal(object, newPosition)
+	if err == nil && !found {
+		err = ErrObjectDoesNotExist(object)
+	}
+
 	if err != nil {
+		p.rejectMovement(object, newPosition, err)
 		return err
 	}
 
+	p.removeObjectUnchecked(objectPosition.Object, objectPosition.Position)
+	p.placeObjectUnchecked(object, newPosition)
+	p.listeners.emit(environmenttypes.Event{
+		Kind:   environmenttypes.EventObjectMoved,
+		Object: object,
+		From:   objectPosition.Position,
+		To:     newPosition,
+	})
+
+	return nil
+}
+
+// PlaceObjectIfVacant attempts to insert a new object into the plateau at the
+// specified position, but only if no other objects are present at that
+// position. See PlaceObject for the rules that are enforced when placing
+// objects.
+//
+// If the position is occupied, the object is not placed, and the objects
+// present at the position are returned.
+func (p *Plateau) PlaceObjectIfVacant(object objectiface.Objecter, position spatial.Point) (bool, []objectiface.Objecter, error) {
+	if object == nil {
+		return false, nil, ErrNilObject()
+	}
+
+	err := p.verifyObjectPositionIsLegal(object, position)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if occupants := p.objects.objectsAt(position); len(occupants) > 0 {
+		return false, occupants, nil
+	}
+
+	err = p.PlaceObject(object, position)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, nil, nil
+}
+
+// MoveObjectIfVacant records the movement of an object from one position in
+// the plateau to another, but only if no other objects are present at the new
+// position. See RecordMovement for details.
+//
+// If the new position is occupied, the object is not moved, the objects present
+// at the new position are returned, and the rejected movement is reported to
+// any subscribed listeners.
+func (p *Plateau) MoveObjectIfVacant(object objectiface.Objecter, newPosition spatial.Point) (bool, []objectiface.Objecter, error) {
+	if object == nil {
+		return false, nil, ErrNilObject()
+	}
+
+	if p.verifyPositionIsLegal(newPosition) == nil {
+		if occupants := p.objects.objectsAt(newPosition); len(occupants) > 0 {
+			p.rejectMovement(object, newPosition, ErrPositionOccupied(object, newPosition, occupants))
+			return false, occupants, nil
+		}
+	}
+
+	err := p.RecordMovement(object, newPosition)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, nil, nil
+}
+
+// RemoveObject takes an object out of the plateau, so that the object no
+// longer occupies any position. An error is returned if the object is nil, or
+// if the object is not present within the plateau.
+func (p *Plateau) RemoveObject(object objectiface.Objecter) error {
+	if object == nil {
+		return ErrNilObject()
+	}
+
 	found, objectPosition := p.FindObject(object)
 	if !found {
 		return ErrObjectDoesNotExist(object)
 	}
 
-	p.removeObjectUnchecked(objectPosition.Object)
-	p.placeObjectUnchecked(object, newPosition)
+	p.removeObjectUnchecked(objectPosition.Object, objectPosition.Position)
+	p.listeners.emit(environmenttypes.Event{
+		Kind:   environmenttypes.EventObjectRemoved,
+		Object: objectPosition.Object,
+		From:   objectPosition.Position,
+	})
+	return nil
+}
 
+// SetHeightMap attaches a height map to the plateau, which describes the
+// elevation of each position within the plateau (see Elevation). Supplying a
+// nil height map makes the plateau flat.
+//
+// An error is returned if the size of the height map does not match the
+// bounds of the plateau.
+func (p *Plateau) SetHeightMap(heightMap *HeightMap) error {
+	if heightMap != nil &&
+		(heightMap.Width() != p.bounds.Width() || heightMap.Height() != p.bounds.Height()) {
+		return ErrHeightMapMismatch(heightMap.Width(), heightMap.Height(), p.bounds)
+	}
+	p.heightMap = heightMap
 	return nil
 }
 
+// HeightMap returns the height map attached to the plateau, or nil if the
+// plateau is flat (see SetHeightMap).
+func (p *Plateau) HeightMap() *HeightMap {
+	return p.heightMap
+}
+
+// SetMask attaches a mask to the plateau, which describes the shape of the
+// plateau. Once a mask is attached, positions outside of the mask are treated
+// as being outside of the plateau's bounds, so they cannot be occupied or
+// inspected. Supplying a nil mask makes the plateau rectangular.
+//
+// An error is returned if the mask is a grid whose size does not match the
+// bounds of the plateau. Objects already present within the plateau are
+// unaffected, even if they lie outside of the mask.
+func (p *Plateau) SetMask(mask *Mask) error {
+	if mask != nil && mask.IsGrid() &&
+		(mask.Width() != p.bounds.Width() || mask.Height() != p.bounds.Height()) {
+		return ErrMaskMismatch(mask.Width(), mask.Height(), p.bounds)
+	}
+	p.mask = mask
+	return nil
+}
+
+// Mask returns the mask attached to the plateau, or nil if the plateau is
+// rectangular (see SetMask).
+func (p *Plateau) Mask() *Mask {
+	return p.mask
+}
+
+// Elevation returns the elevation of a position within the plateau. If the
+// plateau has no height map, the elevation is always 0.
+//
+// If the position does not exist within the plateau, this method will return
+// 0 and an error.
+func (p *Plateau) Elevation(position spatial.Point) (int, error) {
+	err := p.verifyPositionIsLegal(position)
+	if err != nil {
+		return 0, err
+	}
+
+	if p.heightMap == nil {
+		return 0, nil
+	}
+	return p.heightMap.elevation(spatial.NewPoint(position.X-p.bounds.Min.X, position.Y-p.bounds.Min.Y)), nil
+}
+
+// Subscribe registers a listener, which will receive each subsequent event
+// within the plateau, in the order in which the events occur. Calling the
+// returned function removes the listener.
+//
+// Listeners are called synchronously, so they must not block, and must not
+// call back into the plateau.
+func (p *Plateau) Subscribe(listener environmenttypes.Listener) func() {
+	return p.listeners.subscribe(listener)
+}
+
 // ShowObjects returns a sparse map of points within the terrain that
-// contain objects.
+// contain objects. The map is a copy, so callers may retain or modify it
+// without affecting the plateau.
 func (p *Plateau) ShowObjects() map[spatial.Point][]objectiface.Objecter {
-	return p.objects
+	return p.objects.occupied()
 }
 
 // FindObject searches the environment for an object (via the object's ID)
 // and if the object is found, returns true and the object and its position.
 // If the object is not found in the environment, FindObject returns false.
 func (p *Plateau) FindObject(objectToFind objectiface.Objecter) (bool, *environmenttypes.ObjectPosition) {
-	for position, objects := range p.objects {
-		for _, object := range objects {
-			if object.ID() == objectToFind.ID() {
-				return true, &environmenttypes.ObjectPosition{
-					Position: position,
-					Object:   object,
-				}
+	if objectToFind == nil {
+		return false, nil
+	}
+
+	id := objectToFind.ID()
+	position, found := p.index[id]
+	if !found {
+		return false, nil
+	}
+
+	for _, object := range p.objects.objectsAt(position) {
+		if object.ID() == id {
+			return true, &environmenttypes.ObjectPosition{
+				Position: position,
+				Object:   object,
 			}
 		}
 	}
@@ -127,41 +424,59 @@ func (p *Plateau) InspectPosition(positionToInspect spatial.Point) (bool, []obje
 		return false, nil, err
 	}
 
-	for knownPosition, objects := range p.objects {
-		if knownPosition == positionToInspect {
-			if objects != nil && len(objects) > 0 {
-				return true, objects, nil
-			}
-			break
-		}
+	if objects := p.objects.objectsAt(positionToInspect); len(objects) > 0 {
+		return true, objects, nil
 	}
 	return false, nil, nil
 }
 
 func (p *Plateau) verifyPositionIsLegal(position spatial.Point) error {
-	if position.X > p.dimensions.X || position.Y > p.dimensions.Y ||
-		position.Y < 0 || position.X < 0 {
+	if !p.bounds.Contains(position) {
+		return ErrPositionOutsideBounds(position)
+	}
+	if p.mask != nil && !p.mask.contains(p.bounds, position) {
 		return ErrPositionOutsideBounds(position)
 	}
 	return nil
 }
 
-// removeObjectUnchecked removes an object from the environment without checking
-// if the object already exists nor performing any other validity checks.
-func (p *Plateau) removeObjectUnchecked(object objectiface.Objecter) {
-	for position, existingObjects := range p.objects {
-		objectsAtCurrentPosition := []objectiface.Objecter{}
-		for _, existingObject := range existingObjects {
-			if existingObject.ID() != object.ID() {
-				objectsAtCurrentPosition = append(objectsAtCurrentPosition, existingObject)
-			}
-		}
-		if len(objectsAtCurrentPosition) == 0 {
-			delete(p.objects, position)
-		} else {
-			p.objects[position] = objectsAtCurrentPosition
+// verifyObjectPositionIsLegal behaves like verifyPositionIsLegal, but
+// identifies the object that attempted to occupy the position within any
+// error.
+func (p *Plateau) verifyObjectPositionIsLegal(object objectiface.Objecter, position spatial.Point) error {
+	err := p.verifyPositionIsLegal(position)
+	if err != nil {
+		return ErrObjectOutsideBounds(object, position)
+	}
+	return nil
+}
+
+// rejectMovement reports a rejected movement to any subscribed listeners.
+func (p *Plateau) rejectMovement(object objectiface.Objecter, newPosition spatial.Point, err error) {
+	event := environmenttypes.Event{
+		Kind:   environmenttypes.EventMoveRejected,
+		Object: object,
+		To:     newPosition,
+		Err:    err,
+	}
+	if found, objectPosition := p.FindObject(object); found {
+		event.From = objectPosition.Position
+	}
+	p.listeners.emit(event)
+}
+
+// removeObjectUnchecked removes an object from the specified position within
+// the environment without checking if the object exists at that position nor
+// performing any other validity checks.
+func (p *Plateau) removeObjectUnchecked(object objectiface.Objecter, position spatial.Point) {
+	objectsAtPosition := []objectiface.Objecter{}
+	for _, existingObject := range p.objects.objectsAt(position) {
+		if existingObject.ID() != object.ID() {
+			objectsAtPosition = append(objectsAtPosition, existingObject)
 		}
 	}
+	p.objects.setObjectsAt(position, objectsAtPosition)
+	delete(p.index, object.ID())
 }
 
 // placeObjectUnchecked places the specified object at the specified position.
@@ -169,13 +484,14 @@ func (p *Plateau) removeObjectUnchecked(object objectiface.Objecter) {
 // of the specified coordinates, without checking if the object is nil, and
 // without checking if the object already exists elsewhere in the environment.
 func (p *Plateau) placeObjectUnchecked(object objectiface.Objecter, newPosition spatial.Point) {
-	if objectList, found := p.objects[newPosition]; found {
-		objectList = append(objectList, object)
-		p.objects[newPosition] = objectList
-	} else {
-		p.objects[newPosition] = []objectiface.Objecter{object}
-	}
+	p.objects.setObjectsAt(newPosition, append(p.objects.objectsAt(newPosition), object))
+	p.index[object.ID()] = newPosition
 }
 
-// enforce that Plateau implements Environmenter
-var _ environmentiface.Environmenter = (*Plateau)(nil)
+// enforce that Plateau implements VacancyChecker, Observable and
+// ElevationMapper
+var (
+	_ environmentiface.VacancyChecker  = (*Plateau)(nil)
+	_ environmentiface.Observable      = (*Plateau)(nil)
+	_ environmentiface.ElevationMapper = (*Plateau)(nil)
+)
@@ -1,6 +1,7 @@
 package environment_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -8,19 +9,20 @@ import (
 
 	mock_objectiface "github.com/jecolasurdo/marsrover/mocks/objects"
 	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
 	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
 func Test_PlateauGetDimensions(t *testing.T) {
 	expectedDimensions := spatial.NewPoint(5, 9)
-	p := environment.Plateau{}.NewPlateau(expectedDimensions)
+	p := newPlateau(expectedDimensions)
 	assert.Equal(t, p.GetDimensions(), expectedDimensions)
 }
 
 func Test_PlateauShowObjects(t *testing.T) {
 	t.Run("returns empty map if no objects present", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 
 		actResult := p.ShowObjects()
 		expResult := make(map[spatial.Point][]objectiface.Objecter)
@@ -43,7 +45,7 @@ func Test_PlateauShowObjects(t *testing.T) {
 			{X: 2, Y: 3}: {mockObject2},
 		}
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		for position, objects := range mockObjects {
 			for _, object := range objects {
 				err := p.PlaceObject(object, position)
@@ -61,7 +63,7 @@ func Test_PlateauShowObjects(t *testing.T) {
 
 func Test_PlateauPlaceObjects(t *testing.T) {
 	t.Run("nil object returns an error", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		err := p.PlaceObject(nil, spatial.Point{X: 1, Y: 1})
 		assert.EqualError(t, err, environment.ErrNilObject().Error())
 	})
@@ -99,11 +101,18 @@ func Test_PlateauPlaceObjects(t *testing.T) {
 				ctrl := gomock.NewController(t)
 				defer ctrl.Finish()
 				mockObject := mock_objectiface.NewMockObjecter(ctrl)
+				mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-				p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+				p := newPlateau(spatial.Point{X: 10, Y: 10})
 				position := spatial.NewPoint(testCase.X, testCase.Y)
 				err := p.PlaceObject(mockObject, position)
 				assert.EqualError(t, err, environment.ErrPositionOutsideBounds(position).Error())
+
+				var outsideBounds *environment.PositionOutsideBoundsError
+				if assert.True(t, errors.As(err, &outsideBounds)) {
+					assert.Equal(t, position, outsideBounds.Position)
+					assert.Equal(t, "A", outsideBounds.ObjectID)
+				}
 			})
 		}
 	})
@@ -115,7 +124,7 @@ func Test_PlateauPlaceObjects(t *testing.T) {
 		mockObject := mock_objectiface.NewMockObjecter(ctrl)
 		mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		err := p.PlaceObject(mockObject, spatial.Point{X: 1, Y: 1})
 		assert.NoError(t, err)
 
@@ -135,7 +144,7 @@ func Test_PlateauPlaceObjects(t *testing.T) {
 
 		sharedLocation := spatial.Point{X: 1, Y: 1}
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 
 		err := p.PlaceObject(mockObjectA, sharedLocation)
 		assert.NoError(t, err)
@@ -162,7 +171,7 @@ func Test_PlateauPlaceObjects(t *testing.T) {
 		mockObjectB := mock_objectiface.NewMockObjecter(ctrl)
 		mockObjectB.EXPECT().ID().Return("B").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 
 		locationOne := spatial.Point{X: 1, Y: 1}
 		err := p.PlaceObject(mockObjectA, locationOne)
@@ -184,7 +193,7 @@ func Test_PlateauPlaceObjects(t *testing.T) {
 
 func Test_PlateauRecordMovement(t *testing.T) {
 	t.Run("cannot record the movement of a nil object", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		err := p.RecordMovement(nil, spatial.Point{X: 3, Y: 3})
 		assert.Error(t, err, environment.ErrNilObject().Error())
 	})
@@ -222,8 +231,9 @@ func Test_PlateauRecordMovement(t *testing.T) {
 				ctrl := gomock.NewController(t)
 				defer ctrl.Finish()
 				mockObject := mock_objectiface.NewMockObjecter(ctrl)
+				mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-				p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+				p := newPlateau(spatial.Point{X: 10, Y: 10})
 				initialPosition := spatial.NewPoint(5, 5)
 				err := p.PlaceObject(mockObject, initialPosition)
 				assert.NoError(t, err)
@@ -231,6 +241,11 @@ func Test_PlateauRecordMovement(t *testing.T) {
 				newPosition := spatial.NewPoint(testCase.X, testCase.Y)
 				err = p.RecordMovement(mockObject, newPosition)
 				assert.EqualError(t, err, environment.ErrPositionOutsideBounds(newPosition).Error())
+
+				var outsideBounds *environment.PositionOutsideBoundsError
+				if assert.True(t, errors.As(err, &outsideBounds)) {
+					assert.Equal(t, "A", outsideBounds.ObjectID)
+				}
 			})
 		}
 	})
@@ -241,7 +256,7 @@ func Test_PlateauRecordMovement(t *testing.T) {
 		mockObject := mock_objectiface.NewMockObjecter(ctrl)
 		mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		err := p.RecordMovement(mockObject, spatial.Point{X: 5, Y: 5})
 
 		assert.EqualError(t, err, environment.ErrObjectDoesNotExist(mockObject).Error())
@@ -254,7 +269,7 @@ func Test_PlateauRecordMovement(t *testing.T) {
 		mockObject := mock_objectiface.NewMockObjecter(ctrl)
 		mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		initialPosition := spatial.Point{X: 4, Y: 5}
 		err := p.PlaceObject(mockObject, initialPosition)
 		assert.NoError(t, err)
@@ -278,7 +293,7 @@ func Test_PlateauRecordMovement(t *testing.T) {
 		mockObjectB := mock_objectiface.NewMockObjecter(ctrl)
 		mockObjectB.EXPECT().ID().Return("B").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.NewPoint(10, 10))
+		p := newPlateau(spatial.NewPoint(10, 10))
 
 		positionA := spatial.NewPoint(4, 5)
 		err := p.PlaceObject(mockObjectA, positionA)
@@ -306,7 +321,7 @@ func Test_PlateauRecordMovement(t *testing.T) {
 
 func Test_PlateauFindObject(t *testing.T) {
 	t.Run("finding a nil object returns false, nil", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		found, objectPosition := p.FindObject(nil)
 		assert.False(t, found)
 		assert.Nil(t, objectPosition)
@@ -318,7 +333,7 @@ func Test_PlateauFindObject(t *testing.T) {
 		mockObject := mock_objectiface.NewMockObjecter(ctrl)
 		mockObject.EXPECT().ID().Return("A").AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		found, object := p.FindObject(mockObject)
 
 		assert.False(t, found)
@@ -332,7 +347,7 @@ func Test_PlateauFindObject(t *testing.T) {
 		objectID := "A"
 		mockObject.EXPECT().ID().Return(objectID).AnyTimes()
 
-		p := environment.Plateau{}.NewPlateau(spatial.Point{X: 10, Y: 10})
+		p := newPlateau(spatial.Point{X: 10, Y: 10})
 		position := spatial.Point{X: 4, Y: 5}
 		err := p.PlaceObject(mockObject, position)
 		assert.NoError(t, err)
@@ -347,7 +362,7 @@ func Test_PlateauFindObject(t *testing.T) {
 
 func Test_PlateauInspectPosition(t *testing.T) {
 	t.Run("no objects at position succeeds", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.NewPoint(10, 10))
+		p := newPlateau(spatial.NewPoint(10, 10))
 		found, objects, err := p.InspectPosition(spatial.NewPoint(5, 5))
 		assert.False(t, found)
 		assert.Nil(t, objects)
@@ -355,7 +370,7 @@ func Test_PlateauInspectPosition(t *testing.T) {
 	})
 
 	t.Run("illegal position returns error", func(t *testing.T) {
-		p := environment.Plateau{}.NewPlateau(spatial.NewPoint(10, 10))
+		p := newPlateau(spatial.NewPoint(10, 10))
 		illegalPosition := spatial.NewPoint(20, 20)
 		found, objects, err := p.InspectPosition(illegalPosition)
 		assert.False(t, found)
@@ -363,3 +378,286 @@ func Test_PlateauInspectPosition(t *testing.T) {
 		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(illegalPosition).Error())
 	})
 }
+
+func Test_PlateauErrors(t *testing.T) {
+	t.Run("nil objects", func(t *testing.T) {
+		p := newPlateau(spatial.NewPoint(10, 10))
+		err := p.PlaceObject(nil, spatial.NewPoint(1, 1))
+
+		var nilObject *environment.NilObjectError
+		assert.True(t, errors.As(err, &nilObject))
+	})
+
+	t.Run("positions outside the bounds", func(t *testing.T) {
+		p := newPlateau(spatial.NewPoint(10, 10))
+		position := spatial.NewPoint(11, 1)
+		_, _, err := p.InspectPosition(position)
+
+		var outsideBounds *environment.PositionOutsideBoundsError
+		assert.True(t, errors.As(err, &outsideBounds))
+		assert.Equal(t, position, outsideBounds.Position)
+	})
+
+	t.Run("duplicate objects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockObject := mock_objectiface.NewMockObjecter(ctrl)
+		mockObject.EXPECT().ID().Return("A").AnyTimes()
+
+		p := newPlateau(spatial.NewPoint(10, 10))
+		assert.NoError(t, p.PlaceObject(mockObject, spatial.NewPoint(1, 1)))
+		err := p.PlaceObject(mockObject, spatial.NewPoint(2, 2))
+
+		var alreadyExists *environment.ObjectAlreadyExistsError
+		assert.True(t, errors.As(err, &alreadyExists))
+		assert.Equal(t, "A", alreadyExists.ObjectID)
+	})
+
+	t.Run("missing objects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockObject := mock_objectiface.NewMockObjecter(ctrl)
+		mockObject.EXPECT().ID().Return("A").AnyTimes()
+
+		p := newPlateau(spatial.NewPoint(10, 10))
+		err := p.RecordMovement(mockObject, spatial.NewPoint(2, 2))
+
+		var doesNotExist *environment.ObjectDoesNotExistError
+		assert.True(t, errors.As(err, &doesNotExist))
+		assert.Equal(t, "A", doesNotExist.ObjectID)
+	})
+}
+
+func Test_PlateauRemoveObject(t *testing.T) {
+	t.Run("removing a nil object returns an error", func(t *testing.T) {
+		p := newPlateau(spatial.NewPoint(10, 10))
+		err := p.RemoveObject(nil)
+		assert.EqualError(t, err, environment.ErrNilObject().Error())
+	})
+
+	t.Run("removing a missing object returns an error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockObject := mock_objectiface.NewMockObjecter(ctrl)
+		mockObject.EXPECT().ID().Return("A").AnyTimes()
+
+		p := newPlateau(spatial.NewPoint(10, 10))
+		err := p.RemoveObject(mockObject)
+		assert.EqualError(t, err, environment.ErrObjectDoesNotExist(mockObject).Error())
+	})
+
+	t.Run("a removed object no longer occupies its position", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockObjectA := mock_objectiface.NewMockObjecter(ctrl)
+		mockObjectA.EXPECT().ID().Return("A").AnyTimes()
+		mockObjectB := mock_objectiface.NewMockObjecter(ctrl)
+		mockObjectB.EXPECT().ID().Return("B").AnyTimes()
+
+		p := newPlateau(spatial.NewPoint(10, 10))
+		position := spatial.NewPoint(3, 4)
+		assert.NoError(t, p.PlaceObject(mockObjectA, position))
+		assert.NoError(t, p.PlaceObject(mockObjectB, position))
+
+		assert.NoError(t, p.RemoveObject(mockObjectA))
+		found, _ := p.FindObject(mockObjectA)
+		assert.False(t, found)
+		_, objects, err := p.InspectPosition(position)
+		assert.NoError(t, err)
+		assert.Equal(t, []objectiface.Objecter{mockObjectB}, objects)
+
+		assert.NoError(t, p.RemoveObject(mockObjectB))
+		occupied, _, err := p.InspectPosition(position)
+		assert.NoError(t, err)
+		assert.False(t, occupied)
+		assert.Empty(t, p.ShowObjects())
+
+		// The object can be placed again once it has been removed.
+		assert.NoError(t, p.PlaceObject(mockObjectA, position))
+	})
+}
+
+func Test_NewPlateau(t *testing.T) {
+	t.Run("negative dimensions return an error", func(t *testing.T) {
+		testCases := []spatial.Point{
+			spatial.NewPoint(-1, 5),
+			spatial.NewPoint(5, -1),
+			spatial.NewPoint(-1, -1),
+		}
+		for _, dimensions := range testCases {
+			p, err := environment.Plateau{}.NewPlateau(dimensions)
+			assert.Nil(t, p)
+			assert.EqualError(t, err, environment.ErrNegativeDimensions(dimensions).Error())
+
+			var negativeDimensions *environment.NegativeDimensionsError
+			assert.True(t, errors.As(err, &negativeDimensions))
+		}
+	})
+
+	t.Run("zero dimensions describe a single position", func(t *testing.T) {
+		p, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(0, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.Rectangle{}, p.GetBounds())
+	})
+
+	t.Run("inverted bounds return an error", func(t *testing.T) {
+		bounds := spatial.NewRectangle(spatial.NewPoint(5, 0), spatial.NewPoint(4, 10))
+		p, err := environment.Plateau{}.NewBoundedPlateau(bounds)
+		assert.Nil(t, p)
+		assert.EqualError(t, err, environment.ErrInvertedBounds(bounds).Error())
+
+		var invertedBounds *environment.InvertedBoundsError
+		assert.True(t, errors.As(err, &invertedBounds))
+	})
+
+	t.Run("bounds can lie in negative space", func(t *testing.T) {
+		bounds := spatial.NewRectangle(spatial.NewPoint(-10, -10), spatial.NewPoint(10, 10))
+		p, err := environment.Plateau{}.NewBoundedPlateau(bounds)
+		assert.NoError(t, err)
+		assert.Equal(t, bounds, p.GetBounds())
+		assert.Equal(t, spatial.NewPoint(10, 10), p.GetDimensions())
+
+		for _, position := range []spatial.Point{{X: -10, Y: -10}, {X: 10, Y: 10}, {X: 0, Y: 0}} {
+			_, _, err := p.InspectPosition(position)
+			assert.NoError(t, err)
+		}
+		for _, position := range []spatial.Point{{X: -11, Y: 0}, {X: 0, Y: -11}, {X: 11, Y: 0}} {
+			_, _, err := p.InspectPosition(position)
+			assert.EqualError(t, err, environment.ErrPositionOutsideBounds(position).Error())
+		}
+	})
+
+	t.Run("bounds whose area overflows an int use sparse storage", func(t *testing.T) {
+		const maxInt = int(^uint(0) >> 1)
+		testCases := []spatial.Rectangle{
+			spatial.NewRectangle(spatial.NewPoint(0, 0), spatial.NewPoint(4294967295, 4294967295)),
+			spatial.NewRectangle(spatial.NewPoint(0, 0), spatial.NewPoint(maxInt, 1)),
+		}
+		for _, bounds := range testCases {
+			plateaus := []environmentiface.Environmenter{}
+			for _, expectedObjects := range []int{-1, 0, 1, maxInt} {
+				p, err := (&environment.PlateauBuilder{ExpectedObjects: expectedObjects}).NewEnvironment(bounds)
+				assert.NoError(t, err)
+				plateaus = append(plateaus, p)
+			}
+			p, err := environment.Plateau{}.NewBoundedPlateau(bounds, environment.DenseStorage())
+			assert.NoError(t, err)
+			plateaus = append(plateaus, p)
+
+			for _, p := range plateaus {
+				obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+				assert.NoError(t, p.PlaceObject(obstacle, bounds.Max))
+				found, objectPosition := p.FindObject(obstacle)
+				assert.True(t, found)
+				assert.Equal(t, bounds.Max, objectPosition.Position)
+			}
+		}
+	})
+}
+
+// newPlateau constructs a plateau for a test, and panics if the plateau cannot
+// be constructed.
+func newPlateau(dimensions spatial.Point) *environment.Plateau {
+	p, err := environment.Plateau{}.NewPlateau(dimensions)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// benchmarkObjectCount is the number of objects placed on the plateau for each
+// benchmark.
+const benchmarkObjectCount = 10000
+
+// benchmarkStorages are the storage configurations against which each
+// benchmark is run.
+var benchmarkStorages = []struct {
+	name    string
+	options []environment.PlateauOption
+}{
+	{"sparse", nil},
+	{"dense", []environment.PlateauOption{environment.DenseStorage()}},
+}
+
+// benchmarkPlateau runs a benchmark against a plateau configured with each of
+// the benchmarkStorages.
+func benchmarkPlateau(b *testing.B, benchmark func(b *testing.B, p *environment.Plateau, objects []objectiface.Objecter)) {
+	for _, storage := range benchmarkStorages {
+		b.Run(storage.name, func(b *testing.B) {
+			p, objects := newBenchmarkPlateau(storage.options...)
+			b.ResetTimer()
+			benchmark(b, p, objects)
+		})
+	}
+}
+
+// newBenchmarkPlateau returns a plateau populated with benchmarkObjectCount
+// obstacles (one per position), along with the obstacles.
+func newBenchmarkPlateau(options ...environment.PlateauOption) (*environment.Plateau, []objectiface.Objecter) {
+	const size = 200
+	p, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(size, size), options...)
+	if err != nil {
+		panic(err)
+	}
+	objects := make([]objectiface.Objecter, 0, benchmarkObjectCount)
+	for i := 0; i < benchmarkObjectCount; i++ {
+		object := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		err := p.PlaceObject(object, spatial.NewPoint(i%(size+1), i/(size+1)))
+		if err != nil {
+			panic(err)
+		}
+		objects = append(objects, object)
+	}
+	return p, objects
+}
+
+func Benchmark_PlateauFindObject(b *testing.B) {
+	benchmarkPlateau(b, func(b *testing.B, p *environment.Plateau, objects []objectiface.Objecter) {
+		for i := 0; i < b.N; i++ {
+			p.FindObject(objects[i%len(objects)])
+		}
+	})
+}
+
+func Benchmark_PlateauInspectPosition(b *testing.B) {
+	benchmarkPlateau(b, func(b *testing.B, p *environment.Plateau, _ []objectiface.Objecter) {
+		for i := 0; i < b.N; i++ {
+			_, _, _ = p.InspectPosition(spatial.NewPoint(i%201, (i/201)%50))
+		}
+	})
+}
+
+func Benchmark_PlateauRecordMovement(b *testing.B) {
+	benchmarkPlateau(b, func(b *testing.B, p *environment.Plateau, objects []objectiface.Objecter) {
+		object := objects[len(objects)/2]
+		positions := []spatial.Point{spatial.NewPoint(200, 200), spatial.NewPoint(199, 200)}
+		for i := 0; i < b.N; i++ {
+			err := p.RecordMovement(object, positions[i%len(positions)])
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Benchmark_PlateauPlaceObject(b *testing.B) {
+	benchmarkPlateau(b, func(b *testing.B, p *environment.Plateau, _ []objectiface.Objecter) {
+		for i := 0; i < b.N; i++ {
+			object := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+			err := p.PlaceObject(object, spatial.NewPoint(200, 200))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func Benchmark_PlateauShowObjects(b *testing.B) {
+	benchmarkPlateau(b, func(b *testing.B, p *environment.Plateau, _ []objectiface.Objecter) {
+		for i := 0; i < b.N; i++ {
+			p.ShowObjects()
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package environment
+
+import (
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// A PlateauBuilder builds plateaus, choosing sparse or dense storage for each
+// plateau based on the plateau's area and the number of objects that the
+// plateau is expected to hold (see AutoStorage).
+type PlateauBuilder struct {
+	// ExpectedObjects is an estimate of the number of objects (such as
+	// rovers and obstacles) that each plateau will hold. If ExpectedObjects
+	// is 0, every plateau uses sparse storage.
+	ExpectedObjects int
+}
+
+// NewEnvironment instantiates a new Plateau spanning the specified bounds. See
+// Plateau.NewBoundedPlateau for details.
+func (b *PlateauBuilder) NewEnvironment(bounds spatial.Rectangle) (environmentiface.Environmenter, error) {
+	plateau, err := Plateau{}.NewBoundedPlateau(bounds, AutoStorage(b.ExpectedObjects))
+	if err != nil {
+		return nil, err
+	}
+	return plateau, nil
+}
+
+// enforce that PlateauBuilder implements EnvironmentBuilder
+var _ environmentiface.EnvironmentBuilder = (*PlateauBuilder)(nil)
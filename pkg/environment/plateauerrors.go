@@ -7,24 +7,230 @@ import (
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
+// NilObjectError occurs if a nil object is used illegally.
+type NilObjectError struct{}
+
 // ErrNilObject occurs if a nil object is used illegally.
 func ErrNilObject() error {
-	return fmt.Errorf("the environment cannot interact with a nil object")
+	return &NilObjectError{}
+}
+
+func (e *NilObjectError) Error() string {
+	return "the environment cannot interact with a nil object"
+}
+
+// ObjectAlreadyExistsError occurs if an object already exists where/when it
+// shouldn't.
+type ObjectAlreadyExistsError struct {
+	ObjectID string
 }
 
 // ErrObjectAlreadyExists occurs if an object already exists where/when it
 // shouldn't.
 func ErrObjectAlreadyExists(object objectiface.Objecter) error {
-	return fmt.Errorf("object with ID '%s' already exists within the environment", object.ID())
+	return &ObjectAlreadyExistsError{ObjectID: object.ID()}
+}
+
+func (e *ObjectAlreadyExistsError) Error() string {
+	return fmt.Sprintf("object with ID '%s' already exists within the environment", e.ObjectID)
+}
+
+// ObjectDoesNotExistError occurs if an object does not exist where/when it
+// should.
+type ObjectDoesNotExistError struct {
+	ObjectID string
 }
 
 // ErrObjectDoesNotExist occurs if an object does not exist where/when it should.
 func ErrObjectDoesNotExist(object objectiface.Objecter) error {
-	return fmt.Errorf("object with ID '%s' does not exist within the environment", object.ID())
+	return &ObjectDoesNotExistError{ObjectID: object.ID()}
+}
+
+func (e *ObjectDoesNotExistError) Error() string {
+	return fmt.Sprintf("object with ID '%s' does not exist within the environment", e.ObjectID)
+}
+
+// PositionOutsideBoundsError occurs if a position is outside of the bounds of
+// the environment in a situation where that is prohibited.
+type PositionOutsideBoundsError struct {
+	Position spatial.Point
+
+	// ObjectID is the ID of the object that attempted to occupy the position,
+	// or empty if no object was involved (for instance, if the position was
+	// only inspected).
+	ObjectID string
 }
 
 // ErrPositionOutsideBounds occurs if a position is outside of the bounds of the
 // environment in a situation where that is prohibited.
 func ErrPositionOutsideBounds(position spatial.Point) error {
-	return fmt.Errorf("position '%v' is outside the bounds of the environment", position)
+	return &PositionOutsideBoundsError{Position: position}
+}
+
+// ErrObjectOutsideBounds occurs if an object attempts to occupy a position
+// that is outside of the bounds of the environment.
+func ErrObjectOutsideBounds(object objectiface.Objecter, position spatial.Point) error {
+	return &PositionOutsideBoundsError{Position: position, ObjectID: object.ID()}
+}
+
+func (e *PositionOutsideBoundsError) Error() string {
+	return fmt.Sprintf("position '%v' is outside the bounds of the environment", e.Position)
+}
+
+// PositionOccupiedError occurs if an object attempts to occupy a position that
+// is already occupied by other objects, in a situation where that is
+// prohibited (see Plateau.MoveObjectIfVacant).
+type PositionOccupiedError struct {
+	Position spatial.Point
+
+	// ObjectID is the ID of the object that attempted to occupy the position.
+	ObjectID string
+
+	// OccupantIDs are the IDs of the objects present at the position.
+	OccupantIDs []string
+}
+
+// ErrPositionOccupied occurs if an object attempts to occupy a position that
+// is already occupied by other objects, in a situation where that is
+// prohibited.
+func ErrPositionOccupied(object objectiface.Objecter, position spatial.Point, occupants []objectiface.Objecter) error {
+	occupantIDs := []string{}
+	for _, occupant := range occupants {
+		occupantIDs = append(occupantIDs, occupant.ID())
+	}
+	return &PositionOccupiedError{Position: position, ObjectID: object.ID(), OccupantIDs: occupantIDs}
+}
+
+func (e *PositionOccupiedError) Error() string {
+	return fmt.Sprintf("position '%v' is already occupied", e.Position)
+}
+
+// NegativeDimensionsError occurs if an environment is constructed with negative
+// dimensions.
+type NegativeDimensionsError struct {
+	Dimensions spatial.Point
+}
+
+// ErrNegativeDimensions occurs if an environment is constructed with negative
+// dimensions.
+func ErrNegativeDimensions(dimensions spatial.Point) error {
+	return &NegativeDimensionsError{Dimensions: dimensions}
+}
+
+func (e *NegativeDimensionsError) Error() string {
+	return fmt.Sprintf("dimensions '%v' cannot be negative", e.Dimensions)
+}
+
+// InvertedBoundsError occurs if an environment is constructed with bounds whose
+// minimum corner exceeds its maximum corner.
+type InvertedBoundsError struct {
+	Bounds spatial.Rectangle
+}
+
+// ErrInvertedBounds occurs if an environment is constructed with bounds whose
+// minimum corner exceeds its maximum corner.
+func ErrInvertedBounds(bounds spatial.Rectangle) error {
+	return &InvertedBoundsError{Bounds: bounds}
+}
+
+func (e *InvertedBoundsError) Error() string {
+	return fmt.Sprintf("bounds from '%v' to '%v' are inverted; the minimum corner cannot exceed the maximum corner", e.Bounds.Min, e.Bounds.Max)
+}
+
+// BoundsTooLargeError occurs if an environment is constructed with bounds that
+// are too large for the environment to support.
+type BoundsTooLargeError struct {
+	Bounds spatial.Rectangle
+}
+
+// ErrBoundsTooLarge occurs if an environment is constructed with bounds that
+// are too large for the environment to support.
+func ErrBoundsTooLarge(bounds spatial.Rectangle) error {
+	return &BoundsTooLargeError{Bounds: bounds}
+}
+
+func (e *BoundsTooLargeError) Error() string {
+	return fmt.Sprintf("bounds from '%v' to '%v' are too large for the environment", e.Bounds.Min, e.Bounds.Max)
+}
+
+// InvalidHeightMapError occurs if a height map is malformed.
+type InvalidHeightMapError struct {
+	// Line is the 1-based line (or row) of the height map at which the
+	// problem was found, or 0 if the problem concerns the entire map.
+	Line int
+
+	// Reason describes the problem.
+	Reason string
+}
+
+// ErrInvalidHeightMap occurs if a height map is malformed.
+func ErrInvalidHeightMap(line int, reason string) error {
+	return &InvalidHeightMapError{Line: line, Reason: reason}
+}
+
+func (e *InvalidHeightMapError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("invalid height map: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid height map at line %v: %s", e.Line, e.Reason)
+}
+
+// HeightMapMismatchError occurs if a height map's size does not match the
+// bounds of the environment that it is attached to.
+type HeightMapMismatchError struct {
+	Width  int
+	Height int
+	Bounds spatial.Rectangle
+}
+
+// ErrHeightMapMismatch occurs if a height map's size does not match the bounds
+// of the environment that it is attached to.
+func ErrHeightMapMismatch(width, height int, bounds spatial.Rectangle) error {
+	return &HeightMapMismatchError{Width: width, Height: height, Bounds: bounds}
+}
+
+func (e *HeightMapMismatchError) Error() string {
+	return fmt.Sprintf("a %vx%v height map cannot describe an environment spanning from '%v' to '%v' (expected %vx%v)",
+		e.Width, e.Height, e.Bounds.Min, e.Bounds.Max, e.Bounds.Width(), e.Bounds.Height())
+}
+
+// InvalidMaskError occurs if a mask is malformed.
+type InvalidMaskError struct {
+	// Line is the 1-based line (or row) of the mask at which the problem was
+	// found, or 0 if the problem concerns the entire mask.
+	Line int
+
+	// Reason describes the problem.
+	Reason string
+}
+
+// ErrInvalidMask occurs if a mask is malformed.
+func ErrInvalidMask(line int, reason string) error {
+	return &InvalidMaskError{Line: line, Reason: reason}
+}
+
+func (e *InvalidMaskError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("invalid mask: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid mask at line %v: %s", e.Line, e.Reason)
+}
+
+// MaskMismatchError occurs if a grid mask's size does not match the bounds of
+// the environment that it is attached to.
+type MaskMismatchError struct {
+	Width  int
+	Height int
+	Bounds spatial.Rectangle
+}
+
+// ErrMaskMismatch occurs if a grid mask's size does not match the bounds of
+// the environment that it is attached to.
+func ErrMaskMismatch(width, height int, bounds spatial.Rectangle) error {
+	return &MaskMismatchError{Width: width, Height: height, Bounds: bounds}
+}
+
+func (e *MaskMismatchError) Error() string {
+	return fmt.Sprintf("a %vx%v mask cannot describe an environment spanning from '%v' to '%v' (expected %vx%v)",
+		e.Width, e.Height, e.Bounds.Min, e.Bounds.Max, e.Bounds.Width(), e.Bounds.Height())
 }
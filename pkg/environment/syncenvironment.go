@@ -0,0 +1,224 @@
+package environment
+
+import (
+	"sync"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttypes"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// SyncEnvironment wraps another environment (such as a Plateau or a Torus) so
+// that the environment can safely be shared by objects that are controlled
+// from separate goroutines.
+//
+// Each call to the environment is serialized. In addition, SyncEnvironment
+// implements AtomicEnvironmenter, which allows objects (such as rovers) to
+// check that a position is vacant and occupy it as a single operation.
+//
+// The wrapped environment must not be used directly once it has been wrapped.
+type SyncEnvironment struct {
+	mu  *sync.RWMutex
+	env environmentiface.Environmenter
+}
+
+// NewSyncEnvironment wraps the supplied environment, and returns a reference
+// to the resulting SyncEnvironment.
+func (SyncEnvironment) NewSyncEnvironment(env environmentiface.Environmenter) *SyncEnvironment {
+	return &SyncEnvironment{
+		mu:  new(sync.RWMutex),
+		env: env,
+	}
+}
+
+// GetDimensions returns the dimension of the environment.
+func (s *SyncEnvironment) GetDimensions() spatial.Point {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.env.GetDimensions()
+}
+
+// GetBounds returns the bounds of the environment.
+func (s *SyncEnvironment) GetBounds() spatial.Rectangle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.env.GetBounds()
+}
+
+// PlaceObject attempts to insert a new object into the environment at the
+// specified position.
+func (s *SyncEnvironment) PlaceObject(object objectiface.Objecter, position spatial.Point) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.env.PlaceObject(object, position)
+}
+
+// PlaceObjectIfVacant attempts to insert a new object into the environment at
+// the specified position, but only if no other objects are present at that
+// position. If the wrapped environment is a VacancyChecker, the check is
+// delegated to it.
+func (s *SyncEnvironment) PlaceObjectIfVacant(object objectiface.Objecter, position spatial.Point) (bool, []objectiface.Objecter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if checker, ok := s.env.(environmentiface.VacancyChecker); ok {
+		placed, objects, err := checker.PlaceObjectIfVacant(object, position)
+		return placed, copyObjectList(objects), err
+	}
+	return s.ifVacant(position, func() error {
+		return s.env.PlaceObject(object, position)
+	})
+}
+
+// RecordMovement records the movement of an object from one position in the
+// environment to another.
+func (s *SyncEnvironment) RecordMovement(object objectiface.Objecter, newPosition spatial.Point) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.env.RecordMovement(object, newPosition)
+}
+
+// MoveObjectIfVacant records the movement of an object from one position in
+// the environment to another, but only if no other objects are present at the
+// new position. If the wrapped environment is a VacancyChecker, the check is
+// delegated to it, so that the wrapped environment can report the movement if
+// it is rejected.
+func (s *SyncEnvironment) MoveObjectIfVacant(object objectiface.Objecter, newPosition spatial.Point) (bool, []objectiface.Objecter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if checker, ok := s.env.(environmentiface.VacancyChecker); ok {
+		moved, objects, err := checker.MoveObjectIfVacant(object, newPosition)
+		return moved, copyObjectList(objects), err
+	}
+	return s.ifVacant(newPosition, func() error {
+		return s.env.RecordMovement(object, newPosition)
+	})
+}
+
+// RemoveObject takes an object out of the environment.
+func (s *SyncEnvironment) RemoveObject(object objectiface.Objecter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.env.RemoveObject(object)
+}
+
+// ShowObjects returns a copy of the sparse map of points within the terrain
+// that contain objects.
+func (s *SyncEnvironment) ShowObjects() map[spatial.Point][]objectiface.Objecter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyObjects(s.env.ShowObjects())
+}
+
+// FindObject searches the environment for an object (via the object's ID)
+// and if the object is found, returns true and the object and its position.
+// If the object is not found in the environment, FindObject returns false.
+func (s *SyncEnvironment) FindObject(objectToFind objectiface.Objecter) (bool, *environmenttypes.ObjectPosition) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.env.FindObject(objectToFind)
+}
+
+// InspectPosition attempts to return any objects that may be present at
+// a specified position. The returned list of objects is a copy.
+func (s *SyncEnvironment) InspectPosition(positionToInspect spatial.Point) (bool, []objectiface.Objecter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	occupied, objects, err := s.env.InspectPosition(positionToInspect)
+	return occupied, copyObjectList(objects), err
+}
+
+// Elevation returns the elevation of a position within the environment. If the
+// wrapped environment is not an ElevationMapper, the environment is treated as
+// flat, and the elevation of every position within its bounds is 0.
+func (s *SyncEnvironment) Elevation(position spatial.Point) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	mapper, ok := s.env.(environmentiface.ElevationMapper)
+	if !ok {
+		if !s.env.GetBounds().Contains(position) {
+			return 0, ErrPositionOutsideBounds(position)
+		}
+		return 0, nil
+	}
+	return mapper.Elevation(position)
+}
+
+// Atomically calls fn with exclusive access to the wrapped environment, which
+// is supplied to fn, and returns the error returned by fn. Calling the
+// SyncEnvironment from within fn would deadlock, so fn must only use the
+// environment supplied to it.
+func (s *SyncEnvironment) Atomically(fn func(environmentiface.Environmenter) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.env)
+}
+
+// Normalize returns the point that the wrapped environment considers to refer
+// to the same position as the supplied point. If the wrapped environment is not
+// a PositionNormalizer, the point is returned unchanged.
+func (s *SyncEnvironment) Normalize(position spatial.Point) spatial.Point {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	normalizer, ok := s.env.(environmentiface.PositionNormalizer)
+	if !ok {
+		return position
+	}
+	return normalizer.Normalize(position)
+}
+
+// Subscribe registers a listener with the wrapped environment, which will
+// receive each subsequent event within the environment, in the order in which
+// the events occur. Calling the returned function removes the listener.
+//
+// Listeners are called while the environment is locked, so they must not call
+// back into the environment. If the wrapped environment is not Observable,
+// the listener never receives any events.
+func (s *SyncEnvironment) Subscribe(listener environmenttypes.Listener) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	observable, ok := s.env.(environmentiface.Observable)
+	if !ok {
+		return func() {}
+	}
+
+	unsubscribe := observable.Subscribe(listener)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		unsubscribe()
+	}
+}
+
+// ifVacant inspects a position, and calls occupy if the position is vacant.
+// The caller must hold the write lock.
+func (s *SyncEnvironment) ifVacant(position spatial.Point, occupy func() error) (bool, []objectiface.Objecter, error) {
+	occupied, objects, err := s.env.InspectPosition(position)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if occupied {
+		return false, copyObjectList(objects), nil
+	}
+
+	err = occupy()
+	if err != nil {
+		return false, nil, err
+	}
+	return true, nil, nil
+}
+
+// enforce that SyncEnvironment implements AtomicEnvironmenter, Observable,
+// ElevationMapper and PositionNormalizer
+var (
+	_ environmentiface.AtomicEnvironmenter = (*SyncEnvironment)(nil)
+	_ environmentiface.Observable          = (*SyncEnvironment)(nil)
+	_ environmentiface.ElevationMapper     = (*SyncEnvironment)(nil)
+	_ environmentiface.PositionNormalizer  = (*SyncEnvironment)(nil)
+)
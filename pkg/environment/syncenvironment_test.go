@@ -0,0 +1,154 @@
+package environment_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_SyncEnvironmentIfVacant(t *testing.T) {
+	env := environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(5, 5)))
+	rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+	crater := environment.Obstacle{}.NewObstacle(environment.ObstacleCrater)
+
+	t.Run("placing into a vacant position", func(t *testing.T) {
+		placed, occupants, err := env.PlaceObjectIfVacant(rock, spatial.NewPoint(1, 1))
+		assert.NoError(t, err)
+		assert.True(t, placed)
+		assert.Nil(t, occupants)
+	})
+
+	t.Run("placing into an occupied position", func(t *testing.T) {
+		placed, occupants, err := env.PlaceObjectIfVacant(crater, spatial.NewPoint(1, 1))
+		assert.NoError(t, err)
+		assert.False(t, placed)
+		assert.Equal(t, []objectiface.Objecter{rock}, occupants)
+
+		found, _ := env.FindObject(crater)
+		assert.False(t, found)
+	})
+
+	t.Run("placing outside of the environment", func(t *testing.T) {
+		placed, occupants, err := env.PlaceObjectIfVacant(crater, spatial.NewPoint(6, 1))
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(6, 1)).Error())
+		assert.False(t, placed)
+		assert.Nil(t, occupants)
+	})
+
+	t.Run("moving into an occupied position", func(t *testing.T) {
+		assert.NoError(t, env.PlaceObject(crater, spatial.NewPoint(2, 2)))
+		moved, occupants, err := env.MoveObjectIfVacant(crater, spatial.NewPoint(1, 1))
+		assert.NoError(t, err)
+		assert.False(t, moved)
+		assert.Equal(t, []objectiface.Objecter{rock}, occupants)
+	})
+
+	t.Run("moving into a vacant position", func(t *testing.T) {
+		moved, occupants, err := env.MoveObjectIfVacant(crater, spatial.NewPoint(2, 3))
+		assert.NoError(t, err)
+		assert.True(t, moved)
+		assert.Nil(t, occupants)
+
+		_, objectPosition := env.FindObject(crater)
+		assert.Equal(t, spatial.NewPoint(2, 3), objectPosition.Position)
+	})
+}
+
+func Test_SyncEnvironmentConcurrentPlacement(t *testing.T) {
+	const contenders = 50
+	env := environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(5, 5)))
+	target := spatial.NewPoint(3, 3)
+
+	placements := make(chan bool, contenders)
+	wg := sync.WaitGroup{}
+	for i := 0; i < contenders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+			placed, _, err := env.PlaceObjectIfVacant(rock, target)
+			assert.NoError(t, err)
+			placements <- placed
+		}()
+	}
+	wg.Wait()
+	close(placements)
+
+	placedCount := 0
+	for placed := range placements {
+		if placed {
+			placedCount++
+		}
+	}
+	assert.Equal(t, 1, placedCount)
+	assert.Len(t, env.ShowObjects()[target], 1)
+}
+
+func Test_SyncEnvironmentAtomically(t *testing.T) {
+	t.Run("calls are not interleaved", func(t *testing.T) {
+		const contenders = 50
+		env := environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(5, 5)))
+		target := spatial.NewPoint(3, 3)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < contenders; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := env.Atomically(func(env environmentiface.Environmenter) error {
+					occupied, _, err := env.InspectPosition(target)
+					if err != nil || occupied {
+						return err
+					}
+					return env.PlaceObject(environment.Obstacle{}.NewObstacle(environment.ObstacleRock), target)
+				})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		assert.Len(t, env.ShowObjects()[target], 1)
+	})
+
+	t.Run("the error from fn is returned", func(t *testing.T) {
+		env := environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(5, 5)))
+		err := env.Atomically(func(env environmentiface.Environmenter) error {
+			return env.RecordMovement(environment.Obstacle{}.NewObstacle(environment.ObstacleRock), spatial.NewPoint(1, 1))
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("only synchronized environments are atomic", func(t *testing.T) {
+		torus, err := environment.Torus{}.NewTorus(spatial.NewPoint(5, 5))
+		assert.NoError(t, err)
+		for _, env := range []interface{}{newPlateau(spatial.NewPoint(5, 5)), torus} {
+			_, atomic := env.(environmentiface.AtomicEnvironmenter)
+			assert.False(t, atomic, "%T", env)
+		}
+	})
+}
+
+func Test_ShowObjectsReturnsACopy(t *testing.T) {
+	position := spatial.NewPoint(1, 1)
+	environments := map[string]environmentiface.Environmenter{
+		"plateau": newPlateau(spatial.NewPoint(5, 5)),
+		"sync":    environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau(spatial.NewPoint(5, 5))),
+	}
+	for name, env := range environments {
+		t.Run(name, func(t *testing.T) {
+			rock := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+			assert.NoError(t, env.PlaceObject(rock, position))
+
+			objects := env.ShowObjects()
+			objects[position][0] = nil
+			delete(objects, position)
+
+			assert.Equal(t, map[spatial.Point][]objectiface.Objecter{position: {rock}}, env.ShowObjects())
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package environment
+
+import (
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttypes"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// Torus is a rectangular environment whose edges wrap around, such that an
+// object leaving one edge of the environment re-enters on the opposite edge.
+//
+// Like a Plateau, a Torus spans its bounds (inclusive). Positions outside of
+// the bounds are normalized back into the environment, so (Max.X+1, y) refers
+// to the same position as (Min.X, y), and (Min.X-1, y) refers to the same
+// position as (Max.X, y).
+type Torus struct {
+	plateau *Plateau
+}
+
+// NewTorus instantiates a new Torus spanning from (0,0) to the specified
+// dimensions (inclusive), and returns a reference to that instance.
+//
+// An error is returned if either of the dimensions is negative, or if the
+// dimensions are too large for the torus to wrap around (see NewBoundedTorus).
+func (Torus) NewTorus(dimensions spatial.Point, options ...PlateauOption) (*Torus, error) {
+	if dimensions.X < 0 || dimensions.Y < 0 {
+		return nil, ErrNegativeDimensions(dimensions)
+	}
+	return Torus{}.NewBoundedTorus(spatial.NewRectangle(spatial.Point{}, dimensions), options...)
+}
+
+// NewBoundedTorus instantiates a new Torus spanning the specified bounds
+// (inclusive), and returns a reference to that instance. The options are
+// applied to the plateau underlying the torus (see NewBoundedPlateau).
+//
+// An error is returned if the bounds are inverted (if the minimum corner
+// exceeds the maximum corner), or if the bounds are so large that the number of
+// positions across (or along) the torus cannot be represented as an int, since
+// the torus could not then wrap positions around its edges.
+func (Torus) NewBoundedTorus(bounds spatial.Rectangle, options ...PlateauOption) (*Torus, error) {
+	if !bounds.Inverted() && (bounds.Width() <= 0 || bounds.Height() <= 0) {
+		return nil, ErrBoundsTooLarge(bounds)
+	}
+
+	plateau, err := Plateau{}.NewBoundedPlateau(bounds, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Torus{plateau: plateau}, nil
+}
+
+// GetDimensions returns the dimension of the environment, which is the
+// upper-right corner of the environment's bounds.
+func (t *Torus) GetDimensions() spatial.Point {
+	return t.plateau.GetDimensions()
+}
+
+// GetBounds returns the bounds of the environment.
+func (t *Torus) GetBounds() spatial.Rectangle {
+	return t.plateau.GetBounds()
+}
+
+// PlaceObject attempts to insert a new object into the environment at the
+// specified position, after normalizing the position. See Plateau.PlaceObject
+// for the rules that are enforced when placing objects.
+func (t *Torus) PlaceObject(object objectiface.Objecter, position spatial.Point) error {
+	return t.plateau.PlaceObject(object, t.Normalize(position))
+}
+
+// RecordMovement records the movement of an object from one position in the
+// environment to another, after normalizing the new position.
+func (t *Torus) RecordMovement(object objectiface.Objecter, newPosition spatial.Point) error {
+	return t.plateau.RecordMovement(object, t.Normalize(newPosition))
+}
+
+// PlaceObjectIfVacant attempts to insert a new object into the environment at
+// the specified position, after normalizing the position, but only if no other
+// objects are present at that position. See Plateau.PlaceObjectIfVacant for
+// details.
+func (t *Torus) PlaceObjectIfVacant(object objectiface.Objecter, position spatial.Point) (bool, []objectiface.Objecter, error) {
+	return t.plateau.PlaceObjectIfVacant(object, t.Normalize(position))
+}
+
+// MoveObjectIfVacant records the movement of an object from one position in
+// the environment to another, after normalizing the new position, but only if
+// no other objects are present at the new position. See
+// Plateau.MoveObjectIfVacant for details.
+func (t *Torus) MoveObjectIfVacant(object objectiface.Objecter, newPosition spatial.Point) (bool, []objectiface.Objecter, error) {
+	return t.plateau.MoveObjectIfVacant(object, t.Normalize(newPosition))
+}
+
+// RemoveObject takes an object out of the environment. See
+// Plateau.RemoveObject for details.
+func (t *Torus) RemoveObject(object objectiface.Objecter) error {
+	return t.plateau.RemoveObject(object)
+}
+
+// ShowObjects returns a sparse map of points within the terrain that
+// contain objects. Each point is normalized.
+func (t *Torus) ShowObjects() map[spatial.Point][]objectiface.Objecter {
+	return t.plateau.ShowObjects()
+}
+
+// FindObject searches the environment for an object (via the object's ID)
+// and if the object is found, returns true and the object and its normalized
+// position. If the object is not found in the environment, FindObject returns
+// false.
+func (t *Torus) FindObject(objectToFind objectiface.Objecter) (bool, *environmenttypes.ObjectPosition) {
+	return t.plateau.FindObject(objectToFind)
+}
+
+// InspectPosition attempts to return any objects that may be present at
+// a specified position, after normalizing the position. See
+// Plateau.InspectPosition for details.
+func (t *Torus) InspectPosition(positionToInspect spatial.Point) (bool, []objectiface.Objecter, error) {
+	return t.plateau.InspectPosition(t.Normalize(positionToInspect))
+}
+
+// SetHeightMap attaches a height map to the environment. See
+// Plateau.SetHeightMap for details.
+func (t *Torus) SetHeightMap(heightMap *HeightMap) error {
+	return t.plateau.SetHeightMap(heightMap)
+}
+
+// SetMask attaches a mask to the environment. Positions are normalized before
+// they are checked against the mask, so a torus with a mask still wraps around
+// the edges of its bounds. See Plateau.SetMask for details.
+func (t *Torus) SetMask(mask *Mask) error {
+	return t.plateau.SetMask(mask)
+}
+
+// Elevation returns the elevation of a position within the environment, after
+// normalizing the position. See Plateau.Elevation for details.
+func (t *Torus) Elevation(position spatial.Point) (int, error) {
+	return t.plateau.Elevation(t.Normalize(position))
+}
+
+// Subscribe registers a listener, which will receive each subsequent event
+// within the environment. Each event refers to normalized positions. See
+// Plateau.Subscribe for details.
+func (t *Torus) Subscribe(listener environmenttypes.Listener) func() {
+	return t.plateau.Subscribe(listener)
+}
+
+// Normalize wraps a position around the edges of the environment, such that
+// the resulting position lies within the environment.
+func (t *Torus) Normalize(position spatial.Point) spatial.Point {
+	bounds := t.plateau.GetBounds()
+	return spatial.NewPoint(
+		bounds.Min.X+wrap(position.X-bounds.Min.X, bounds.Width()),
+		bounds.Min.Y+wrap(position.Y-bounds.Min.Y, bounds.Height()),
+	)
+}
+
+// wrap returns value modulo size, such that the result is always within the
+// range [0, size).
+func wrap(value, size int) int {
+	value %= size
+	if value < 0 {
+		value += size
+	}
+	return value
+}
+
+// enforce that Torus implements VacancyChecker, Observable, ElevationMapper and
+// PositionNormalizer
+var (
+	_ environmentiface.VacancyChecker     = (*Torus)(nil)
+	_ environmentiface.Observable         = (*Torus)(nil)
+	_ environmentiface.ElevationMapper    = (*Torus)(nil)
+	_ environmentiface.PositionNormalizer = (*Torus)(nil)
+)
@@ -0,0 +1,174 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mock_objectiface "github.com/jecolasurdo/marsrover/mocks/objects"
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_TorusGetDimensions(t *testing.T) {
+	expectedDimensions := spatial.NewPoint(5, 9)
+	torus := newTorus(expectedDimensions)
+	assert.Equal(t, expectedDimensions, torus.GetDimensions())
+}
+
+func Test_TorusPlaceObject(t *testing.T) {
+	testCases := []struct {
+		name        string
+		position    spatial.Point
+		expPosition spatial.Point
+	}{
+		{"inside", spatial.NewPoint(2, 3), spatial.NewPoint(2, 3)},
+		{"upper edge", spatial.NewPoint(5, 9), spatial.NewPoint(5, 9)},
+		{"past X upper", spatial.NewPoint(6, 3), spatial.NewPoint(0, 3)},
+		{"past Y upper", spatial.NewPoint(2, 10), spatial.NewPoint(2, 0)},
+		{"below X lower", spatial.NewPoint(-1, 3), spatial.NewPoint(5, 3)},
+		{"below Y lower", spatial.NewPoint(2, -1), spatial.NewPoint(2, 9)},
+		{"several laps", spatial.NewPoint(-13, 23), spatial.NewPoint(5, 3)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			object := mock_objectiface.NewMockObjecter(ctrl)
+			object.EXPECT().ID().Return("1").AnyTimes()
+
+			torus := newTorus(spatial.NewPoint(5, 9))
+			assert.NoError(t, torus.PlaceObject(object, testCase.position))
+
+			found, objectPosition := torus.FindObject(object)
+			assert.True(t, found)
+			assert.Equal(t, testCase.expPosition, objectPosition.Position)
+			assert.Equal(t, map[spatial.Point][]objectiface.Objecter{
+				testCase.expPosition: {object},
+			}, torus.ShowObjects())
+		})
+	}
+
+	t.Run("nil object returns an error", func(t *testing.T) {
+		torus := newTorus(spatial.NewPoint(5, 9))
+		err := torus.PlaceObject(nil, spatial.NewPoint(1, 1))
+		assert.EqualError(t, err, environment.ErrNilObject().Error())
+	})
+}
+
+func Test_TorusRecordMovement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := mock_objectiface.NewMockObjecter(ctrl)
+	object.EXPECT().ID().Return("1").AnyTimes()
+
+	torus := newTorus(spatial.NewPoint(5, 5))
+	assert.NoError(t, torus.PlaceObject(object, spatial.NewPoint(5, 5)))
+	assert.NoError(t, torus.RecordMovement(object, spatial.NewPoint(6, 5)))
+
+	found, objectPosition := torus.FindObject(object)
+	assert.True(t, found)
+	assert.Equal(t, spatial.NewPoint(0, 5), objectPosition.Position)
+}
+
+func Test_TorusInspectPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := mock_objectiface.NewMockObjecter(ctrl)
+	object.EXPECT().ID().Return("1").AnyTimes()
+
+	torus := newTorus(spatial.NewPoint(5, 5))
+	assert.NoError(t, torus.PlaceObject(object, spatial.NewPoint(0, 0)))
+
+	occupied, objects, err := torus.InspectPosition(spatial.NewPoint(6, -6))
+	assert.NoError(t, err)
+	assert.True(t, occupied)
+	assert.Equal(t, []objectiface.Objecter{object}, objects)
+
+	occupied, objects, err = torus.InspectPosition(spatial.NewPoint(7, 0))
+	assert.NoError(t, err)
+	assert.False(t, occupied)
+	assert.Empty(t, objects)
+}
+
+func Test_BoundedTorus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := mock_objectiface.NewMockObjecter(ctrl)
+	object.EXPECT().ID().Return("1").AnyTimes()
+
+	bounds := spatial.NewRectangle(spatial.NewPoint(-2, -2), spatial.NewPoint(2, 2))
+	torus, err := environment.Torus{}.NewBoundedTorus(bounds)
+	assert.NoError(t, err)
+	assert.Equal(t, bounds, torus.GetBounds())
+
+	assert.NoError(t, torus.PlaceObject(object, spatial.NewPoint(3, -3)))
+	found, objectPosition := torus.FindObject(object)
+	assert.True(t, found)
+	assert.Equal(t, spatial.NewPoint(-2, 2), objectPosition.Position)
+
+	_, err = environment.Torus{}.NewTorus(spatial.NewPoint(-1, 2))
+	assert.EqualError(t, err, environment.ErrNegativeDimensions(spatial.NewPoint(-1, 2)).Error())
+}
+
+func Test_TorusExtremeBounds(t *testing.T) {
+	const maxInt = int(^uint(0) >> 1)
+	const minInt = -maxInt - 1
+
+	t.Run("bounds that are too large to wrap are rejected", func(t *testing.T) {
+		testCases := []spatial.Rectangle{
+			spatial.NewRectangle(spatial.NewPoint(minInt, 0), spatial.NewPoint(maxInt, 1)),
+			spatial.NewRectangle(spatial.NewPoint(0, -1), spatial.NewPoint(1, maxInt)),
+		}
+		for _, bounds := range testCases {
+			torus, err := environment.Torus{}.NewBoundedTorus(bounds)
+			assert.Nil(t, torus)
+			assert.EqualError(t, err, environment.ErrBoundsTooLarge(bounds).Error())
+		}
+
+		torus, err := environment.Torus{}.NewTorus(spatial.NewPoint(maxInt, 1))
+		assert.Nil(t, torus)
+		assert.EqualError(t, err, environment.ErrBoundsTooLarge(spatial.NewRectangle(spatial.Point{}, spatial.NewPoint(maxInt, 1))).Error())
+	})
+
+	t.Run("the largest bounds still wrap", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		first := mock_objectiface.NewMockObjecter(ctrl)
+		first.EXPECT().ID().Return("1").AnyTimes()
+		second := mock_objectiface.NewMockObjecter(ctrl)
+		second.EXPECT().ID().Return("2").AnyTimes()
+
+		// The torus is exactly maxInt positions across.
+		bounds := spatial.NewRectangle(spatial.NewPoint(-maxInt/2, 0), spatial.NewPoint(maxInt/2, 0))
+		torus, err := environment.Torus{}.NewBoundedTorus(bounds)
+		assert.NoError(t, err)
+
+		assert.NoError(t, torus.PlaceObject(first, bounds.Max))
+		found, objectPosition := torus.FindObject(first)
+		assert.True(t, found)
+		assert.Equal(t, bounds.Max, objectPosition.Position)
+
+		assert.NoError(t, torus.PlaceObject(second, spatial.NewPoint(maxInt/2+1, 0)))
+		found, objectPosition = torus.FindObject(second)
+		assert.True(t, found)
+		assert.Equal(t, bounds.Min, objectPosition.Position)
+	})
+}
+
+// newTorus constructs a torus for a test, and panics if the torus cannot be
+// constructed.
+func newTorus(dimensions spatial.Point) *environment.Torus {
+	torus, err := environment.Torus{}.NewTorus(dimensions)
+	if err != nil {
+		panic(err)
+	}
+	return torus
+}
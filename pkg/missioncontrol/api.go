@@ -2,56 +2,194 @@
 package missioncontrol
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
-	"strconv"
+	"io"
 	"strings"
 
+	"github.com/jecolasurdo/marsrover/pkg/environment"
 	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects"
 	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
+// roverCommandCount is the number of commands required to deploy and navigate
+// a single rover.
+const roverCommandCount = 2
+
 // A Mission is an interaction between objects (such as rovers) and an
 // environment (such as a martian plateau).
 type Mission struct {
-	envBuilder   environmentiface.EnvironmentBuilder
-	roverBuilder roveriface.RoverBuilder
+	envBuilder      environmentiface.EnvironmentBuilder
+	roverBuilder    roveriface.RoverBuilder
+	continueOnError bool
+	stepBudget      int
+	collisionPolicy Policy
+	boundaryPolicy  Policy
+	energyPolicy    Policy
+	instructions    map[rune]InstructionFunc
+
+	// validationRoverOptions configure the scratch rovers simulated by
+	// Validate.
+	validationRoverOptions []objects.RoverOption
 }
 
-// NewMission constructs a new mission.
+// NewMission constructs a new mission, configured by any supplied options.
 // This function will panic if envBuilder or roverBuilder is nil.
-func NewMission(envBuilder environmentiface.EnvironmentBuilder, roverBuilder roveriface.RoverBuilder) *Mission {
+func NewMission(envBuilder environmentiface.EnvironmentBuilder, roverBuilder roveriface.RoverBuilder, options ...Option) *Mission {
 	if envBuilder == nil || roverBuilder == nil {
 		panic("builders are required")
 	}
-	return &Mission{
-		envBuilder,
-		roverBuilder,
+	mission := &Mission{
+		envBuilder:      envBuilder,
+		roverBuilder:    roverBuilder,
+		collisionPolicy: PolicySkip,
+		boundaryPolicy:  PolicyFail,
+		energyPolicy:    PolicySkip,
+		instructions:    defaultInstructions(),
+	}
+	for _, option := range options {
+		option(mission)
 	}
+	return mission
 }
 
 // ExecuteMission executes a mission in an environment according to the supplied
-// commands.
+// commands, and returns the status of each rover deployed during the mission.
+// See RoverReport.String for the format of each status.
 //
-// This method will immediately halt the mission and return an error if there
-// is any problem detected within the mission.
+// By default, this method will immediately halt the mission and return an
+// error if there is any problem detected within the mission. If the mission
+// was configured with ContinueOnError, then the status of each successful
+// rover is returned, and if any rovers failed, a RoverFailuresError is
+// returned alongside those statuses.
 func (m *Mission) ExecuteMission(commands []string) ([]string, error) {
-	roverstats := []string{}
-	env, commands, err := m.EstablishEnvironment(commands)
+	return m.ExecuteMissionContext(context.Background(), commands)
+}
+
+// ExecuteMissionContext behaves like ExecuteMission, but halts the mission and
+// returns the context's error if the context is cancelled before the mission
+// completes.
+func (m *Mission) ExecuteMissionContext(ctx context.Context, commands []string) ([]string, error) {
+	report, err := m.ExecuteMissionReportContext(ctx, commands)
 	if err != nil {
 		return nil, err
 	}
+	if len(report.Failures) > 0 {
+		return report.Statuses(), ErrRoverFailures(report.Failures)
+	}
+	return report.Statuses(), nil
+}
 
-	for len(commands) > 0 {
-		stats := ""
-		stats, commands, err = m.DeployAndNavigateRover(env, commands)
-		if err != nil {
+// ExecuteMissionReport executes a mission in an environment according to the
+// supplied commands, and returns a report describing each rover deployed during
+// the mission.
+//
+// Obstacle commands (see PlaceObstacleInEnvironment) and retire commands (see
+// RetireRover) may appear wherever a rover's position command is expected.
+// Problems placing an obstacle or retiring a rover always halt the mission.
+//
+// If the mission's RoverBuilder is a RoverRestorer (see roveriface), each rover
+// is given an ID according to the order in which the rovers' commands appear:
+// "rover-1" for the first rover, "rover-2" for the second, and so on (whether
+// or not earlier rovers were deployed successfully). Retire commands can
+// therefore refer to rovers before the mission is executed. Otherwise, each
+// rover's ID is chosen by the RoverBuilder.
+//
+// Blank lines, and lines containing nothing but a comment (anything following
+// a '#'), are ignored. Line numbers reported in errors refer to the supplied
+// commands, including any ignored lines.
+//
+// A rover's navigation command may be omitted (or left blank). A rover whose
+// position command is followed by another position command, an obstacle or
+// retire command, or the end of the commands, is deployed without any
+// navigation instructions.
+//
+// By default, this method will immediately halt the mission and return an
+// error if there is any problem detected within the mission. If the mission
+// was configured with ContinueOnError, then problems with individual rovers
+// are recorded in the report's Failures, and the mission carries on with the
+// remaining rovers. Problems establishing the environment always halt the
+// mission.
+func (m *Mission) ExecuteMissionReport(commands []string) (*MissionReport, error) {
+	return m.ExecuteMissionReportContext(context.Background(), commands)
+}
+
+// ExecuteMissionReportContext behaves like ExecuteMissionReport, but halts the
+// mission and returns the context's error if the context is cancelled before
+// the mission completes.
+func (m *Mission) ExecuteMissionReportContext(ctx context.Context, commands []string) (*MissionReport, error) {
+	report := &MissionReport{Rovers: []RoverReport{}}
+	run := m.newRun(ctx, func(roverReport RoverReport) {
+		report.Rovers = append(report.Rovers, roverReport)
+	})
+
+	for _, command := range commands {
+		if err := run.feed(command); err != nil {
 			return nil, err
 		}
-		roverstats = append(roverstats, stats)
+	}
+	if err := run.finish(); err != nil {
+		return nil, err
+	}
+
+	report.Failures = run.failures
+	return report, nil
+}
+
+// ExecuteMissionStream executes a mission according to commands read from r,
+// where each line of input is a single command.
+//
+// Commands are read incrementally, and each rover is deployed and navigated as
+// soon as its commands have been read. The report for each rover is passed to
+// onRover as soon as that rover has been navigated, so results can be reported
+// while input is still arriving. If onRover is nil, the reports are discarded.
+//
+// This method returns once r has been exhausted, or as soon as a problem is
+// detected within the mission. If the mission was configured with
+// ContinueOnError, then problems with individual rovers do not halt the
+// mission, and if any rovers failed, a RoverFailuresError is returned once r
+// has been exhausted.
+func (m *Mission) ExecuteMissionStream(r io.Reader, onRover func(RoverReport)) error {
+	return m.ExecuteMissionStreamContext(context.Background(), r, onRover)
+}
+
+// ExecuteMissionStreamContext behaves like ExecuteMissionStream, but halts the
+// mission and returns the context's error if the context is cancelled before
+// the mission completes. Cancellation is observed between commands; a read
+// from r that is blocked waiting for input is not interrupted.
+func (m *Mission) ExecuteMissionStreamContext(ctx context.Context, r io.Reader, onRover func(RoverReport)) error {
+	run := m.newRun(ctx, onRover)
+	reader := bufio.NewReader(r)
+	for {
+		command, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		if readErr == io.EOF && command == "" {
+			break
+		}
+
+		if err := run.feed(strings.TrimSuffix(command, "\n")); err != nil {
+			return err
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+	if err := run.finish(); err != nil {
+		return err
 	}
 
-	return roverstats, nil
+	if len(run.failures) > 0 {
+		return ErrRoverFailures(run.failures)
+	}
+	return nil
 }
 
 // EstablishEnvironment attempts to construct a new environment based on the
@@ -62,33 +200,39 @@ func (m *Mission) ExecuteMission(commands []string) ([]string, error) {
 // and return the remaining unused commands for further processing by the
 // caller.
 //
+// The first command is either of the form 'x y', which describes an
+// environment spanning from (0,0) to (x,y), or of the form
+// 'minX minY maxX maxY', which describes an environment spanning from
+// (minX,minY) to (maxX,maxY).
+//
 // If the method succeeds, it will return an environment, and a list of all
 // commands that it did not consume during its operation.
 //
-// If the method fails, only an error is returned.
+// If the method fails, only an error is returned. Parse errors are reported as
+// a *ParseError, with line numbers relative to the supplied commands. Any error
+// returned by the mission's EnvironmentBuilder (such as for inverted bounds) is
+// returned unchanged.
 func (m *Mission) EstablishEnvironment(commands []string) (environmentiface.Environmenter, []string, error) {
+	return m.establishEnvironment(commands, 1)
+}
+
+// establishEnvironment behaves like EstablishEnvironment, where line is the
+// line number of the first command.
+func (m *Mission) establishEnvironment(commands []string, line int) (environmentiface.Environmenter, []string, error) {
 	if len(commands) == 0 {
 		return nil, nil, nil
 	}
 
-	envCommand := commands[0]
-	coords := strings.Split(envCommand, " ")
-	if len(coords) != 2 {
-		return nil, nil, ErrParsingEnvironmentCommand(envCommand)
-	}
-
-	x, err := strconv.Atoi(coords[0])
+	bounds, err := parseEnvironmentCommand(commands[0], line)
 	if err != nil {
-		return nil, nil, ErrParsingEnvironmentCommand(envCommand)
+		return nil, nil, err
 	}
 
-	y, err := strconv.Atoi(coords[1])
+	env, err := m.envBuilder.NewEnvironment(bounds)
 	if err != nil {
-		return nil, nil, ErrParsingEnvironmentCommand(envCommand)
+		return nil, nil, err
 	}
 
-	env := m.envBuilder.NewEnvironment(spatial.NewPoint(x, y))
-
 	return env, commands[1:], nil
 }
 
@@ -105,61 +249,207 @@ func (m *Mission) EstablishEnvironment(commands []string) (environmentiface.Envi
 // list of remaining commands. See NavigateRover for information about the
 // format of the rover's status message.
 //
-// If the method fails, then the only an error is returned.
+// If the method fails, then the only an error is returned. Parse errors are
+// reported as a *ParseError, with line numbers relative to the supplied
+// commands.
 func (m *Mission) DeployAndNavigateRover(env environmentiface.Environmenter, commands []string) (string, []string, error) {
-	if len(commands) < 2 {
-		return "", nil, ErrParsingRoverCommand("expected at least two commands")
-	}
+	return m.DeployAndNavigateRoverContext(context.Background(), env, commands)
+}
 
-	rover, commands, err := m.PlaceRoverInEnvironment(env, commands)
+// DeployAndNavigateRoverContext behaves like DeployAndNavigateRover, but stops
+// navigating the rover and returns the context's error if the context is
+// cancelled before navigation completes. See NavigateRoverContext.
+func (m *Mission) DeployAndNavigateRoverContext(ctx context.Context, env environmentiface.Environmenter, commands []string) (string, []string, error) {
+	report, commands, err := m.deployAndNavigateRover(ctx, env, commands, 1)
 	if err != nil {
 		return "", nil, err
 	}
+	return report.String(), commands, nil
+}
+
+// deployAndNavigateRover behaves like DeployAndNavigateRover, but describes the
+// outcome of the navigation as a RoverReport rather than as a status string.
+// The line argument is the line number of the first command.
+func (m *Mission) deployAndNavigateRover(ctx context.Context, env environmentiface.Environmenter, commands []string, line int) (*RoverReport, []string, error) {
+	if len(commands) < roverCommandCount {
+		return nil, nil, ErrParsingInstructionCommand("", line+len(commands), 1)
+	}
+
+	rover, commands, err := m.placeRoverInEnvironment(env, commands, line, "")
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return m.NavigateRover(rover, commands)
+	return m.navigateRover(ctx, rover, commands, line+1)
 }
 
-// PlaceRoverInEnvironment attempts to establish a new rover and place it
-// within the specified environment.
+// PlaceObstacleInEnvironment attempts to place a static obstacle (such as a
+// rock or a crater) within the specified environment.
 //
 // At least one command must be supplied to this method, and only the first
 // command is observed. If successful, the method will consume the first command,
 // and return the remaining unused commands for further processing by the
 // caller.
 //
-// The command must be formatted as a space delimited string with
-// three fields in the following order: 'x y h' where x is an x position, y
-// is a y position, and h is a heading expressed as a cardinal value N, E, S,
-// or W.
+// The command must be formatted as a whitespace delimited string with three
+// or four fields in the following order: 'obstacle x y k' where x is an x
+// position, y is a y position, and k is the kind of obstacle (rock or crater).
+// If k is omitted, the obstacle is a rock.
 //
-// If the method fails to place a rover in its environment, then only an error
-// is returned.
-func (m *Mission) PlaceRoverInEnvironment(env environmentiface.Environmenter, commands []string) (roveriface.RoverAPI, []string, error) {
+// An obstacle can only be placed at a vacant position. If the position is
+// already occupied (for instance, by a rover), an
+// *environment.PositionOccupiedError is returned.
+//
+// If the method fails to place the obstacle in its environment, then only an
+// error is returned. Parse errors are reported as a *ParseError, with line
+// numbers relative to the supplied commands.
+func (m *Mission) PlaceObstacleInEnvironment(env environmentiface.Environmenter, commands []string) ([]string, error) {
+	return m.placeObstacleInEnvironment(env, commands, 1)
+}
+
+// placeObstacleInEnvironment behaves like PlaceObstacleInEnvironment, where
+// line is the line number of the first command.
+func (m *Mission) placeObstacleInEnvironment(env environmentiface.Environmenter, commands []string, line int) ([]string, error) {
 	if len(commands) < 1 {
-		return nil, nil, ErrParsingRoverCommand("")
+		return nil, ErrParsingObstacleCommand("", line, 1)
+	}
+
+	position, kind, err := parseObstacleCommand(commands[0], line)
+	if err != nil {
+		return nil, err
+	}
+
+	err = placeObstacle(env, environment.Obstacle{}.NewObstacle(kind), position)
+	if err != nil {
+		return nil, err
+	}
+
+	return commands[1:], nil
+}
+
+// placeObstacle places an obstacle within the environment, provided that no
+// other objects are present at the position.
+func placeObstacle(env environmentiface.Environmenter, obstacle *environment.Obstacle, position spatial.Point) error {
+	if checker, ok := env.(environmentiface.VacancyChecker); ok {
+		placed, occupants, err := checker.PlaceObjectIfVacant(obstacle, position)
+		if err != nil {
+			return err
+		}
+		if !placed {
+			return environment.ErrPositionOccupied(obstacle, position, occupants)
+		}
+		return nil
+	}
+
+	occupied, occupants, err := env.InspectPosition(position)
+	if err != nil {
+		return err
+	}
+	if occupied {
+		return environment.ErrPositionOccupied(obstacle, position, occupants)
 	}
+	return env.PlaceObject(obstacle, position)
+}
+
+// RetireRover attempts to decommission a rover, by removing the rover from the
+// specified environment. Once retired, the rover no longer occupies a position
+// within the environment, and so no longer blocks other rovers.
+//
+// At least one command must be supplied to this method, and only the first
+// command is observed. If successful, the method will consume the first command,
+// and return the remaining unused commands for further processing by the
+// caller.
+//
+// The command must be formatted as a whitespace delimited string with two
+// fields in the following order: 'retire id' where id is the ID of the rover
+// (see RoverReport.ID).
+//
+// If the method fails to retire the rover, then only an error is returned.
+// Parse errors are reported as a *ParseError, with line numbers relative to the
+// supplied commands. If no rover with the ID is present within the environment,
+// an *UnknownRoverError is returned.
+func (m *Mission) RetireRover(env environmentiface.Environmenter, commands []string) ([]string, error) {
+	return m.retireRover(env, commands, 1)
+}
 
-	positionCommands := strings.Split(commands[0], " ")
-	if len(positionCommands) != 3 {
-		return nil, nil, ErrParsingRoverCommand(commands[0])
+// retireRover behaves like RetireRover, where line is the line number of the
+// first command.
+func (m *Mission) retireRover(env environmentiface.Environmenter, commands []string, line int) ([]string, error) {
+	if len(commands) < 1 {
+		return nil, ErrParsingRetireCommand("", line, 1)
 	}
 
-	x, err := strconv.Atoi(positionCommands[0])
+	id, err := parseRetireCommand(commands[0], line)
 	if err != nil {
-		return nil, nil, ErrParsingRoverCommand(commands[0])
+		return nil, err
 	}
 
-	y, err := strconv.Atoi(positionCommands[1])
+	err = retire(env, id)
 	if err != nil {
-		return nil, nil, ErrParsingRoverCommand(commands[0])
+		return nil, err
+	}
+
+	return commands[1:], nil
+}
+
+// retire removes the rover with the specified ID from the environment.
+func retire(env environmentiface.Environmenter, id string) error {
+	found, objectPosition := env.FindObject(objectID(id))
+	if !found {
+		return ErrUnknownRover(id)
+	}
+
+	rover, isRover := objectPosition.Object.(roveriface.RoverAPI)
+	if !isRover {
+		return ErrUnknownRover(id)
+	}
+
+	return env.RemoveObject(rover)
+}
+
+// objectID refers to an object within an environment by the object's ID
+// alone.
+type objectID string
+
+// ID returns the ID of the object.
+func (id objectID) ID() string {
+	return string(id)
+}
+
+// PlaceRoverInEnvironment attempts to establish a new rover and place it
+// within the specified environment.
+//
+// At least one command must be supplied to this method, and only the first
+// command is observed. If successful, the method will consume the first command,
+// and return the remaining unused commands for further processing by the
+// caller.
+//
+// The command must be formatted as a whitespace delimited string with
+// three fields in the following order: 'x y h' where x is an x position, y
+// is a y position, and h is a heading expressed as a cardinal value N, E, S,
+// or W. Anything following a '#' is treated as a comment and ignored.
+//
+// If the method fails to place a rover in its environment, then only an error
+// is returned. Parse errors are reported as a *ParseError, with line numbers
+// relative to the supplied commands.
+func (m *Mission) PlaceRoverInEnvironment(env environmentiface.Environmenter, commands []string) (roveriface.RoverAPI, []string, error) {
+	return m.placeRoverInEnvironment(env, commands, 1, "")
+}
+
+// placeRoverInEnvironment behaves like PlaceRoverInEnvironment, where line is
+// the line number of the first command. If id is not empty, and the mission's
+// RoverBuilder is a RoverRestorer, the rover is given the ID.
+func (m *Mission) placeRoverInEnvironment(env environmentiface.Environmenter, commands []string, line int, id string) (roveriface.RoverAPI, []string, error) {
+	if len(commands) < 1 {
+		return nil, nil, ErrParsingPositionCommand("", line, 1)
 	}
 
-	heading := spatial.HeadingFromString(positionCommands[2])
-	if heading == spatial.HeadingUnknown {
-		return nil, nil, ErrParsingRoverCommand(commands[0])
+	position, heading, err := parsePositionCommand(commands[0], line)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	rover, err := m.roverBuilder.LaunchRover(heading, spatial.NewPoint(x, y), env)
+	rover, err := m.launchRover(id, heading, position, env)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,6 +457,22 @@ func (m *Mission) PlaceRoverInEnvironment(env environmentiface.Environmenter, co
 	return rover, commands[1:], nil
 }
 
+// launchRover launches a rover via the mission's RoverBuilder. If id is not
+// empty, and the RoverBuilder is a RoverRestorer, the rover is given the ID.
+func (m *Mission) launchRover(id string, heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	if restorer, ok := m.roverBuilder.(roveriface.RoverRestorer); ok && id != "" {
+		return restorer.RestoreRover(id, heading, position, env)
+	}
+	return m.roverBuilder.LaunchRover(heading, position, env)
+}
+
+// missionRoverID returns the ID given to a rover deployed during a mission
+// (see ExecuteMissionReport), where index is the zero-based position of the
+// rover's commands within the mission's rover commands.
+func missionRoverID(index int) string {
+	return fmt.Sprintf("rover-%v", index+1)
+}
+
 // NavigateRover attempts to maneaver a rover in an environment according to
 // a supplied command.
 //
@@ -175,55 +481,214 @@ func (m *Mission) PlaceRoverInEnvironment(env environmentiface.Environmenter, co
 // and return the remaining unused commands for further processing by the
 // caller.
 //
-// Valid values for the command are L, which represents a 90 degree turn to the
-// left, R, which represents a 90 degree turn to the right, and M, which
-// represents a move forward in the rover's current heading.
+// Each character within the command is an instruction. By default, valid
+// instructions are L, which represents a 90 degree turn to the left, R, which
+// represents a 90 degree turn to the right, M, which represents a move forward
+// in the rover's current heading, B, which represents a move backward without
+// changing the rover's heading, and W, which has the rover wait in place while
+// its battery charges. Additional instructions can be registered (and the
+// default instructions replaced) via the Instruction option.
+//
+// Moves that are blocked by other objects or by the bounds of the environment
+// are handled according to the mission's CollisionPolicy and BoundaryPolicy.
+// Moves and turns that the rover's battery has too little energy for are
+// handled according to the mission's EnergyPolicy.
 //
 // If the method succeeds, then it returns the current status of the rover along
 // with a list of remaining commands.
 //
 // The status of the rover is expressed as a single string with three values as
-// follow: "{x coordinate} {y coordinate} {heading}"
+// follow: "{x coordinate} {y coordinate} {heading}" (see RoverReport.String).
 //
 // If the method fails when navigating the rover, then only an error is
-// returned.
+// returned. Parse errors are reported as a *ParseError, with line numbers
+// relative to the supplied commands.
 func (m *Mission) NavigateRover(rover roveriface.RoverAPI, commands []string) (string, []string, error) {
-	var currentPosition *spatial.Point
+	return m.NavigateRoverContext(context.Background(), rover, commands)
+}
+
+// NavigateRoverContext behaves like NavigateRover, but checks for cancellation
+// of the context between each navigation instruction. If the context is
+// cancelled, the rover is left wherever it was, and the context's error is
+// returned.
+//
+// If the mission was configured with a StepBudget, and the command contains
+// more instructions than the budget allows, the rover is navigated until the
+// budget is exhausted, and a *BudgetExhaustedError describing the rover's
+// state at that point is returned.
+func (m *Mission) NavigateRoverContext(ctx context.Context, rover roveriface.RoverAPI, commands []string) (string, []string, error) {
+	report, commands, err := m.navigateRover(ctx, rover, commands, 1)
+	if err != nil {
+		return "", nil, err
+	}
+	return report.String(), commands, nil
+}
+
+// navigateRover behaves like NavigateRover, but describes the outcome of the
+// navigation as a RoverReport rather than as a status string. The line
+// argument is the line number of the first command.
+func (m *Mission) navigateRover(ctx context.Context, rover roveriface.RoverAPI, commands []string, line int) (*RoverReport, []string, error) {
+	report, err := m.startReport(rover)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if len(commands) != 0 {
-		navigationCommands := strings.Split(commands[0], "")
-		for _, navigationCommand := range navigationCommands {
-			if navigationCommand == "M" {
-				err := rover.Move()
-				if err != nil {
-					if strings.Contains(err.Error(), "incompatible object") {
-						continue
-					}
-					return "", nil, err
+		position := report.StartPosition
+	navigation:
+		for _, token := range splitInstructions(commands[0]) {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+
+			instruction, found := m.instructions[token.instruction]
+			if !found {
+				return nil, nil, ErrParsingInstructionCommand(cleanCommand(commands[0]), line, token.column)
+			}
+
+			if m.stepBudget > 0 && report.CommandsConsumed >= m.stepBudget {
+				if err := recordFinalState(rover, report); err != nil {
+					return nil, nil, err
 				}
-				continue
+				return nil, nil, ErrBudgetExhausted(m.stepBudget, *report)
 			}
 
-			direction := spatial.DirectionFromString(navigationCommand)
-			if direction == spatial.DirectionUnknown {
-				return "", nil, ErrParsingRoverCommand(navigationCommand)
+			report.CommandsConsumed++
+			err := instruction(rover)
+			if err != nil {
+				policy, blocked := m.blockedMovePolicy(err)
+				if !blocked || policy == PolicyFail {
+					return nil, nil, err
+				}
+				report.MovesBlocked++
+				if policy == PolicyStop {
+					break navigation
+				}
+				continue
 			}
 
-			rover.ChangeHeading(direction)
+			currentPosition, err := rover.CurrentPosition()
+			if err != nil {
+				return nil, nil, err
+			}
+			if *currentPosition != position {
+				report.MovesMade++
+				position = *currentPosition
+			}
 		}
 
-		var err error
-		currentPosition, err = rover.CurrentPosition()
-		if err != nil {
-			return "", nil, err
+		if err := recordFinalState(rover, report); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	currentHeading := spatial.HeadingToString(rover.CurrentHeading())
-	roverStats := fmt.Sprintf("%v %v %v", currentPosition.X, currentPosition.Y, currentHeading)
+	if len(commands) <= 1 {
+		return report, nil, nil
+	}
+	return report, commands[1:], nil
+}
+
+// startReport returns a report describing the rover's current state, both as
+// its starting state and as its final state.
+func (m *Mission) startReport(rover roveriface.RoverAPI) (*RoverReport, error) {
+	startPosition, err := rover.CurrentPosition()
+	if err != nil {
+		return nil, err
+	}
+
+	startElevation, err := currentElevation(rover)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RoverReport{
+		ID:             rover.ID(),
+		StartPosition:  *startPosition,
+		StartHeading:   rover.CurrentHeading(),
+		StartElevation: startElevation,
+		FinalPosition:  *startPosition,
+		FinalHeading:   rover.CurrentHeading(),
+		FinalElevation: startElevation,
+		Telemetry:      currentTelemetry(rover),
+	}
+	report.FinalEnergy, report.BatteryPowered = remainingEnergy(rover)
+	return report, nil
+}
+
+// blockedMovePolicy determines whether an error returned from a move indicates
+// that the move was blocked, and if so, returns the policy that applies to the
+// blocked move.
+func (m *Mission) blockedMovePolicy(err error) (Policy, bool) {
+	var incompatibleObject *objects.IncompatibleObjectError
+	if errors.As(err, &incompatibleObject) {
+		return m.collisionPolicy, true
+	}
+
+	var slopeTooSteep *objects.SlopeTooSteepError
+	if errors.As(err, &slopeTooSteep) {
+		return m.collisionPolicy, true
+	}
+
+	var outsideBounds *environment.PositionOutsideBoundsError
+	if errors.As(err, &outsideBounds) {
+		return m.boundaryPolicy, true
+	}
+
+	var insufficientEnergy *objects.InsufficientEnergyError
+	if errors.As(err, &insufficientEnergy) {
+		return m.energyPolicy, true
+	}
+
+	return PolicyUnknown, false
+}
+
+// recordFinalState records the rover's current position, heading, elevation,
+// energy and telemetry as the final state within the report.
+func recordFinalState(rover roveriface.RoverAPI, report *RoverReport) error {
+	currentPosition, err := rover.CurrentPosition()
+	if err != nil {
+		return err
+	}
+
+	elevation, err := currentElevation(rover)
+	if err != nil {
+		return err
+	}
+
+	report.FinalPosition = *currentPosition
+	report.FinalHeading = rover.CurrentHeading()
+	report.FinalElevation = elevation
+	report.FinalEnergy, report.BatteryPowered = remainingEnergy(rover)
+	report.Telemetry = currentTelemetry(rover)
+	return nil
+}
+
+// currentElevation returns the elevation of the rover's current position, or 0
+// if the rover is not an ElevationReporter.
+func currentElevation(rover roveriface.RoverAPI) (int, error) {
+	reporter, ok := rover.(roveriface.ElevationReporter)
+	if !ok {
+		return 0, nil
+	}
+	return reporter.CurrentElevation()
+}
+
+// currentTelemetry returns the rover's record of its activity, or an empty
+// record if the rover is not a TelemetryReporter.
+func currentTelemetry(rover roveriface.RoverAPI) rovertypes.Telemetry {
+	reporter, ok := rover.(roveriface.TelemetryReporter)
+	if !ok {
+		return rovertypes.Telemetry{}
+	}
+	return reporter.Telemetry()
+}
 
-	if len(commands) == 1 {
-		return roverStats, nil, nil
+// remainingEnergy returns the energy remaining within the rover's battery, and
+// true, or 0 and false if the rover has no battery.
+func remainingEnergy(rover roveriface.RoverAPI) (int, bool) {
+	powered, ok := rover.(roveriface.BatteryPowered)
+	if !ok {
+		return 0, false
 	}
-	return roverStats, commands[1:], nil
+	return powered.RemainingEnergy()
 }
@@ -1,6 +1,11 @@
 package missioncontrol_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -11,6 +16,7 @@ import (
 	"github.com/jecolasurdo/marsrover/pkg/missioncontrol"
 	"github.com/jecolasurdo/marsrover/pkg/objects"
 	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,61 +80,103 @@ func Test_ExecutionMission(t *testing.T) {
 			name:     "invalid environment command",
 			commands: []string{"invalid"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingEnvironmentCommand("invalid"),
+			expErr:   missioncontrol.ErrParsingEnvironmentCommand("invalid", 1, 1),
 		},
 		{
 			name:     "invalid env x",
 			commands: []string{"a 10"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingEnvironmentCommand("a 10"),
+			expErr:   missioncontrol.ErrParsingEnvironmentCommand("a 10", 1, 1),
 		},
 		{
 			name:     "invalid env y",
 			commands: []string{"10 a"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingEnvironmentCommand("10 a"),
+			expErr:   missioncontrol.ErrParsingEnvironmentCommand("10 a", 1, 4),
 		},
 		{
 			name:     "incomplete rover command",
 			commands: []string{"10 10", "1 2"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("expected at least two commands"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2", 2, 4),
 		},
 		{
 			name:     "invalid position command",
 			commands: []string{"10 10", "1 2 3 4", "M"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("1 2 3 4"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2 3 4", 2, 5),
 		},
 		{
 			name:     "invalid rover x",
 			commands: []string{"10 10", "a 2 N", "M"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("a 2 N"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("a 2 N", 2, 1),
 		},
 		{
 			name:     "invalid rover y",
 			commands: []string{"10 10", "1 a N", "M"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("1 a N"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 a N", 2, 3),
 		},
 		{
 			name:     "invalid heading",
 			commands: []string{"10 10", "1 2 F", "M"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("1 2 F"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2 F", 2, 5),
 		},
 		{
 			name:     "elided movement results in an error",
 			commands: []string{"10 10", "1 2 F", ""},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("1 2 F"),
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2 F", 2, 5),
+		},
+		{
+			name:     "incomplete environment bounds",
+			commands: []string{"10 10 10"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingEnvironmentCommand("10 10 10", 1, 9),
+		},
+		{
+			name:     "too many environment fields",
+			commands: []string{"-1 -1 10 10 10"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingEnvironmentCommand("-1 -1 10 10 10", 1, 13),
+		},
+		{
+			name:     "missing heading",
+			commands: []string{"10 10", "1 2", "M"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2", 2, 4),
+		},
+		{
+			name:     "extra position field",
+			commands: []string{"10 10", "1 2 N E", "M"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingPositionCommand("1 2 N E", 2, 7),
+		},
+		{
+			name:     "invalid character within a coordinate",
+			commands: []string{"10 10", "12x 2 N", "M"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingPositionCommand("12x 2 N", 2, 3),
+		},
+		{
+			name:     "invalid movement cmd reports the offending column",
+			commands: []string{"10 10", "1 2 N", "LMRXM"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingInstructionCommand("LMRXM", 3, 4),
+		},
+		{
+			name:     "line numbers account for earlier rovers",
+			commands: []string{"10 10", "1 2 N", "M", "3 3 E", "MMQ"},
+			expStats: nil,
+			expErr:   missioncontrol.ErrParsingInstructionCommand("MMQ", 5, 3),
 		},
 		{
 			name:     "invalid movement cmd returns error",
 			commands: []string{"10 10", "1 2 N", "D"},
 			expStats: nil,
-			expErr:   missioncontrol.ErrParsingRoverCommand("D"),
+			expErr:   missioncontrol.ErrParsingInstructionCommand("D", 3, 1),
 		},
 	}
 
@@ -137,24 +185,7 @@ func Test_ExecutionMission(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			roverBuilder := mock_roveriface.NewMockRoverBuilder(ctrl)
-			roverBuilder.EXPECT().
-				LaunchRover(gomock.Any(), gomock.Any(), gomock.Any()).
-				AnyTimes().
-				DoAndReturn(
-					func(h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
-						return objects.Rover{}.LaunchRover(h, p, env)
-					})
-
-			envBuilder := mock_environmentiface.NewMockEnvironmentBuilder(ctrl)
-			envBuilder.EXPECT().
-				NewEnvironment(gomock.Any()).
-				AnyTimes().
-				DoAndReturn(func(p spatial.Point) environmentiface.Environmenter {
-					return environment.Plateau{}.NewPlateau(p)
-				})
-
-			mission := missioncontrol.NewMission(envBuilder, roverBuilder)
+			mission := newTestMission(ctrl)
 			stats, err := mission.ExecuteMission(testCase.commands)
 
 			assert.Equal(t, testCase.expStats, stats)
@@ -166,3 +197,1224 @@ func Test_ExecutionMission(t *testing.T) {
 		})
 	}
 }
+
+func Test_ExecuteMissionReport(t *testing.T) {
+	t.Run("reports the progress of each rover", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"3 3", "0 0 S", "LMMLM", "1 2 W", "LMLMRM"})
+		assert.NoError(t, err)
+		assert.Len(t, report.Rovers, 2)
+
+		first := report.Rovers[0]
+		assert.NotEmpty(t, first.ID)
+		assert.Equal(t, spatial.NewPoint(0, 0), first.StartPosition)
+		assert.Equal(t, spatial.HeadingSouth, first.StartHeading)
+		assert.Equal(t, spatial.NewPoint(2, 1), first.FinalPosition)
+		assert.Equal(t, spatial.HeadingNorth, first.FinalHeading)
+		assert.Equal(t, 5, first.CommandsConsumed)
+		assert.Equal(t, 3, first.MovesMade)
+		assert.Equal(t, 0, first.MovesBlocked)
+
+		// The second rover attempts to move onto the first rover's final
+		// position, and is blocked.
+		second := report.Rovers[1]
+		assert.NotEqual(t, first.ID, second.ID)
+		assert.Equal(t, spatial.NewPoint(1, 2), second.StartPosition)
+		assert.Equal(t, spatial.HeadingWest, second.StartHeading)
+		assert.Equal(t, spatial.NewPoint(1, 0), second.FinalPosition)
+		assert.Equal(t, spatial.HeadingSouth, second.FinalHeading)
+		assert.Equal(t, 6, second.CommandsConsumed)
+		assert.Equal(t, 2, second.MovesMade)
+		assert.Equal(t, 1, second.MovesBlocked)
+
+		assert.Equal(t, []string{"2 1 N", "1 0 S"}, report.Statuses())
+	})
+
+	t.Run("a rover with no navigation commands reports its launch state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"3 3", "1 1 E", ""})
+		assert.NoError(t, err)
+		assert.Len(t, report.Rovers, 1)
+		assert.Equal(t, report.Rovers[0].StartPosition, report.Rovers[0].FinalPosition)
+		assert.Equal(t, 0, report.Rovers[0].CommandsConsumed)
+	})
+
+	t.Run("errors halt the mission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"3 3", "1 1 E", "X"})
+		assert.Nil(t, report)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ExecuteMissionContinueOnError(t *testing.T) {
+	commands := []string{
+		"5 5",
+		"1 2 N", "M",
+		"1 3 E", "M", // launches onto the first rover's position
+		"2 2 F", "M", // invalid heading
+		"4 4 S", "MM",
+	}
+
+	t.Run("failures halt the mission by default", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		stats, err := mission.ExecuteMission(commands)
+		assert.Nil(t, stats)
+		assert.Error(t, err)
+	})
+
+	t.Run("failures are recorded and the mission carries on", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.ContinueOnError())
+		report, err := mission.ExecuteMissionReport(commands)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "4 2 S"}, report.Statuses())
+
+		assert.Len(t, report.Failures, 2)
+		assert.Equal(t, 1, report.Failures[0].Index)
+		assert.Equal(t, []string{"1 3 E", "M"}, report.Failures[0].Commands)
+		assert.Error(t, report.Failures[0].Err)
+		assert.Equal(t, 2, report.Failures[1].Index)
+		assert.Equal(t, []string{"2 2 F", "M"}, report.Failures[1].Commands)
+		assert.EqualError(t, report.Failures[1].Err, missioncontrol.ErrParsingPositionCommand("2 2 F", 6, 5).Error())
+	})
+
+	t.Run("statuses are returned alongside the failures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.ContinueOnError())
+		stats, err := mission.ExecuteMission(commands)
+		assert.Equal(t, []string{"1 3 N", "4 2 S"}, stats)
+
+		failures, ok := err.(*missioncontrol.RoverFailuresError)
+		assert.True(t, ok)
+		assert.Len(t, failures.Failures, 2)
+		assert.Contains(t, err.Error(), "rover-2: ")
+		assert.Contains(t, err.Error(), "; rover-3: "+missioncontrol.ErrParsingPositionCommand("2 2 F", 6, 5).Error())
+	})
+
+	t.Run("a malformed final rover is recorded as a failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.ContinueOnError())
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "1 2 N", "M", "3 3"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N"}, report.Statuses())
+		assert.Len(t, report.Failures, 1)
+		assert.Equal(t, []string{"3 3"}, report.Failures[0].Commands)
+	})
+}
+
+func Test_ParseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mission := newTestMission(ctrl)
+	_, err := mission.ExecuteMission([]string{"5 5", "1 2 N", "LMX"})
+
+	var parseErr *missioncontrol.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 3, parseErr.Line)
+	assert.Equal(t, 3, parseErr.Column)
+	assert.Equal(t, missioncontrol.LineInstructions, parseErr.Expected)
+	assert.Equal(t, "LMX", parseErr.Command)
+	assert.Equal(t, "error parsing instructions command at line 3, column 3: 'LMX'", err.Error())
+}
+
+func Test_ExecuteMissionStream(t *testing.T) {
+	t.Run("spec example 1", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		input := strings.NewReader("5 5\n1 2 N\nLMLMLMLMM\n3 3 E\nMMRMMRMRRM\n")
+		stats := []string{}
+		err := mission.ExecuteMissionStream(input, func(report missioncontrol.RoverReport) {
+			stats = append(stats, report.String())
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "5 1 E"}, stats)
+	})
+
+	t.Run("a nil callback discards the reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		input := strings.NewReader("5 5\n1 2 N\nLMLMLMLMM\n")
+		assert.NotPanics(t, func() {
+			assert.NoError(t, mission.ExecuteMissionStream(input, nil))
+		})
+	})
+
+	t.Run("rovers are reported before input is exhausted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		reader, writer := io.Pipe()
+		reports := make(chan missioncontrol.RoverReport)
+		done := make(chan error)
+		go func() {
+			done <- mission.ExecuteMissionStream(reader, func(report missioncontrol.RoverReport) {
+				reports <- report
+			})
+		}()
+
+		_, err := io.WriteString(writer, "5 5\n1 2 N\nLMLMLMLMM\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "1 3 N", (<-reports).String())
+
+		_, err = io.WriteString(writer, "3 3 E\nMMRMMRMRRM")
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Close())
+		assert.Equal(t, "5 1 E", (<-reports).String())
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("errors halt the mission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		input := strings.NewReader("5 5\n1 2 N\nLMX\n3 3 E\nM\n")
+		stats := []string{}
+		err := mission.ExecuteMissionStream(input, func(report missioncontrol.RoverReport) {
+			stats = append(stats, report.String())
+		})
+		assert.EqualError(t, err, missioncontrol.ErrParsingInstructionCommand("LMX", 3, 3).Error())
+		assert.Empty(t, stats)
+	})
+
+	t.Run("a malformed final rover returns an error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		err := mission.ExecuteMissionStream(strings.NewReader("5 5\n1 2 N\nM\n3 3\n"), func(missioncontrol.RoverReport) {})
+		assert.EqualError(t, err, missioncontrol.ErrParsingPositionCommand("3 3", 4, 4).Error())
+	})
+
+	t.Run("failures are returned once input is exhausted when continuing on error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.ContinueOnError())
+		input := strings.NewReader("5 5\n1 2 N\nLMX\n3 3 E\nM\n")
+		stats := []string{}
+		err := mission.ExecuteMissionStream(input, func(report missioncontrol.RoverReport) {
+			stats = append(stats, report.String())
+		})
+		assert.Equal(t, []string{"4 3 E"}, stats)
+
+		var failures *missioncontrol.RoverFailuresError
+		assert.True(t, errors.As(err, &failures))
+		assert.Len(t, failures.Failures, 1)
+	})
+
+	t.Run("read errors are returned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		reader, writer := io.Pipe()
+		testError := errors.New("test error")
+		assert.NoError(t, writer.CloseWithError(testError))
+		err := mission.ExecuteMissionStream(reader, func(missioncontrol.RoverReport) {})
+		assert.Equal(t, testError, err)
+	})
+}
+
+func Test_ExecuteMissionContext(t *testing.T) {
+	t.Run("a cancelled context halts the mission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mission := newTestMission(ctrl)
+		stats, err := mission.ExecuteMissionContext(ctx, []string{"5 5", "1 2 N", "M"})
+		assert.Nil(t, stats)
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("an uncancelled context completes the mission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		stats, err := mission.ExecuteMissionContext(context.Background(), []string{"5 5", "1 2 N", "LMLMLMLMM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N"}, stats)
+	})
+}
+
+func Test_NavigateRoverContext(t *testing.T) {
+	t.Run("cancellation is observed between instructions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rover := mock_roveriface.NewMockRoverAPI(ctrl)
+		rover.EXPECT().ID().Return("A").AnyTimes()
+		rover.EXPECT().CurrentHeading().Return(spatial.HeadingNorth).AnyTimes()
+		rover.EXPECT().CurrentPosition().Return(&spatial.Point{X: 1, Y: 1}, nil).AnyTimes()
+
+		// The second move cancels the context, so the third is never made.
+		moves := 0
+		rover.EXPECT().Move().Times(2).DoAndReturn(func() error {
+			moves++
+			if moves == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		mission := newTestMission(ctrl)
+		status, commands, err := mission.NavigateRoverContext(ctx, rover, []string{"MMM"})
+		assert.Empty(t, status)
+		assert.Nil(t, commands)
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("exhausting the step budget returns the rover's partial state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.StepBudget(3))
+		_, err := mission.ExecuteMission([]string{"5 5", "1 2 N", "MRMMMM"})
+
+		var budgetErr *missioncontrol.BudgetExhaustedError
+		assert.True(t, errors.As(err, &budgetErr))
+		assert.Equal(t, 3, budgetErr.Budget)
+		assert.Equal(t, 3, budgetErr.Report.CommandsConsumed)
+		assert.Equal(t, 2, budgetErr.Report.MovesMade)
+		assert.Equal(t, spatial.NewPoint(1, 2), budgetErr.Report.StartPosition)
+		assert.Equal(t, spatial.NewPoint(2, 3), budgetErr.Report.FinalPosition)
+		assert.Equal(t, spatial.HeadingEast, budgetErr.Report.FinalHeading)
+	})
+
+	t.Run("instructions within the step budget complete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.StepBudget(3))
+		stats, err := mission.ExecuteMission([]string{"5 5", "1 2 N", "MRM", "4 4 S", "MM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2 3 E", "4 2 S"}, stats)
+	})
+
+	t.Run("validation reports rovers that would exhaust the step budget", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.StepBudget(3))
+		problems := mission.Validate([]string{"5 5", "1 2 N", "MRMMMX", "4 4 S", "MM"})
+		assert.Len(t, problems, 2)
+		if len(problems) != 2 {
+			return
+		}
+
+		var simulationErr *missioncontrol.SimulationError
+		assert.True(t, errors.As(problems[0], &simulationErr))
+		assert.Equal(t, 3, simulationErr.Line)
+		assert.Equal(t, 4, simulationErr.Column)
+
+		var budgetErr *missioncontrol.BudgetExhaustedError
+		assert.True(t, errors.As(problems[0], &budgetErr))
+		assert.Equal(t, 3, budgetErr.Budget)
+		assert.Equal(t, 3, budgetErr.Report.CommandsConsumed)
+		assert.Equal(t, 2, budgetErr.Report.MovesMade)
+		assert.Equal(t, spatial.NewPoint(1, 2), budgetErr.Report.StartPosition)
+		assert.Equal(t, spatial.NewPoint(2, 3), budgetErr.Report.FinalPosition)
+		assert.Equal(t, spatial.HeadingEast, budgetErr.Report.FinalHeading)
+
+		assert.EqualError(t, problems[1], missioncontrol.ErrParsingInstructionCommand("MRMMMX", 3, 6).Error())
+	})
+}
+
+func Test_NavigateRoverErrorClassification(t *testing.T) {
+	t.Run("collisions are skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rover := mock_roveriface.NewMockRoverAPI(ctrl)
+		rover.EXPECT().ID().Return("A").AnyTimes()
+		rover.EXPECT().CurrentHeading().Return(spatial.HeadingNorth).AnyTimes()
+		rover.EXPECT().CurrentPosition().Return(&spatial.Point{X: 1, Y: 1}, nil).AnyTimes()
+		rover.EXPECT().Move().Times(2).
+			Return(fmt.Errorf("wrapped: %w", objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 2))))
+
+		mission := newTestMission(ctrl)
+		status, _, err := mission.NavigateRover(rover, []string{"MM"})
+		assert.NoError(t, err)
+		assert.Equal(t, "1 1 N", status)
+	})
+
+	t.Run("other errors are not mistaken for collisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rover := mock_roveriface.NewMockRoverAPI(ctrl)
+		rover.EXPECT().ID().Return("A").AnyTimes()
+		rover.EXPECT().CurrentHeading().Return(spatial.HeadingNorth).AnyTimes()
+		rover.EXPECT().CurrentPosition().Return(&spatial.Point{X: 1, Y: 1}, nil).AnyTimes()
+		testError := errors.New("an incompatible object, but not a collision")
+		rover.EXPECT().Move().Return(testError)
+
+		mission := newTestMission(ctrl)
+		_, _, err := mission.NavigateRover(rover, []string{"MM"})
+		assert.Equal(t, testError, err)
+	})
+}
+
+func Test_BlockedMovePolicies(t *testing.T) {
+	collision := []string{"2 2", "1 1 N", "", "0 1 E", "MLM"}
+	boundary := []string{"2 2", "0 2 N", "MRM"}
+
+	testCases := []struct {
+		name       string
+		options    []missioncontrol.Option
+		commands   []string
+		expStatus  string
+		expBlocked int
+		expErr     error
+	}{
+		{
+			name:       "collisions are skipped by default",
+			commands:   collision,
+			expStatus:  "0 2 N",
+			expBlocked: 1,
+		},
+		{
+			name:       "collisions can stop the rover",
+			options:    []missioncontrol.Option{missioncontrol.CollisionPolicy(missioncontrol.PolicyStop)},
+			commands:   collision,
+			expStatus:  "0 1 E",
+			expBlocked: 1,
+		},
+		{
+			name:     "collisions can fail the mission",
+			options:  []missioncontrol.Option{missioncontrol.CollisionPolicy(missioncontrol.PolicyFail)},
+			commands: collision,
+			expErr:   objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 1)),
+		},
+		{
+			name:     "boundary violations fail the mission by default",
+			commands: boundary,
+			expErr:   environment.ErrPositionOutsideBounds(spatial.NewPoint(0, 3)),
+		},
+		{
+			name:       "boundary violations can be skipped",
+			options:    []missioncontrol.Option{missioncontrol.BoundaryPolicy(missioncontrol.PolicySkip)},
+			commands:   boundary,
+			expStatus:  "1 2 E",
+			expBlocked: 1,
+		},
+		{
+			name:       "boundary violations can stop the rover",
+			options:    []missioncontrol.Option{missioncontrol.BoundaryPolicy(missioncontrol.PolicyStop)},
+			commands:   boundary,
+			expStatus:  "0 2 N",
+			expBlocked: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mission := newTestMission(ctrl, testCase.options...)
+			report, err := mission.ExecuteMissionReport(testCase.commands)
+			if testCase.expErr != nil {
+				assert.EqualError(t, err, testCase.expErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			lastRover := report.Rovers[len(report.Rovers)-1]
+			assert.Equal(t, testCase.expStatus, lastRover.String())
+			assert.Equal(t, testCase.expBlocked, lastRover.MovesBlocked)
+		})
+	}
+}
+
+func Test_PolicyFromString(t *testing.T) {
+	assert.Equal(t, missioncontrol.PolicySkip, missioncontrol.PolicyFromString("skip"))
+	assert.Equal(t, missioncontrol.PolicyStop, missioncontrol.PolicyFromString("stop"))
+	assert.Equal(t, missioncontrol.PolicyFail, missioncontrol.PolicyFromString("fail"))
+	assert.Equal(t, missioncontrol.PolicyUnknown, missioncontrol.PolicyFromString("ignore"))
+}
+
+func Test_Validate(t *testing.T) {
+	// Validation must never build real rovers, so the rover builder expects
+	// no calls.
+	newValidationMission := testFixture{noRovers: true}.newMission
+
+	t.Run("a valid mission has no problems", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newValidationMission(ctrl)
+		problems := mission.Validate([]string{"5 5", "1 2 N", "LMLMLMLMM", "3 3 E", "MMRMMRMRRM"})
+		assert.Empty(t, problems)
+	})
+
+	t.Run("every problem is reported in one pass", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newValidationMission(ctrl)
+		problems := mission.Validate([]string{
+			"5 5",
+			"1 2 N", "MMMMX", // drives off the plateau, then an invalid instruction
+			"1 5 S", "M", // launches onto the first rover
+			"6 6 N", "M", // launches outside of the plateau
+			"0 5 E", "MQ", // collides with the first rover, then an invalid instruction
+			"2 2 Z", "M", // invalid heading
+			"3 3", // missing heading, and no instructions
+		})
+
+		expProblems := []error{
+			missioncontrol.ErrSimulation(3, 4, environment.ErrPositionOutsideBounds(spatial.NewPoint(1, 6))),
+			missioncontrol.ErrParsingInstructionCommand("MMMMX", 3, 5),
+			missioncontrol.ErrSimulation(4, 1, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 5))),
+			missioncontrol.ErrSimulation(6, 1, environment.ErrPositionOutsideBounds(spatial.NewPoint(6, 6))),
+			missioncontrol.ErrSimulation(9, 1, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 5))),
+			missioncontrol.ErrParsingInstructionCommand("MQ", 9, 2),
+			missioncontrol.ErrParsingPositionCommand("2 2 Z", 10, 5),
+			missioncontrol.ErrParsingPositionCommand("3 3", 12, 4),
+		}
+		assert.Len(t, problems, len(expProblems))
+		for i := range expProblems {
+			assert.EqualError(t, problems[i], expProblems[i].Error())
+		}
+
+		var outsideBounds *environment.PositionOutsideBoundsError
+		assert.True(t, errors.As(problems[0], &outsideBounds))
+	})
+
+	t.Run("instructions are checked for syntax if the environment is invalid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newValidationMission(ctrl)
+		problems := mission.Validate([]string{"5 x", "1 2 N", "MMX"})
+		assert.Len(t, problems, 2)
+		assert.EqualError(t, problems[0], missioncontrol.ErrParsingEnvironmentCommand("5 x", 1, 3).Error())
+		assert.EqualError(t, problems[1], missioncontrol.ErrParsingInstructionCommand("MMX", 3, 3).Error())
+	})
+
+	t.Run("the stop policy halts the simulation of a rover", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newValidationMission(ctrl, missioncontrol.BoundaryPolicy(missioncontrol.PolicyStop))
+		problems := mission.Validate([]string{"2 2", "0 2 N", "MMRMX"})
+		assert.Len(t, problems, 2)
+		assert.EqualError(t, problems[0], missioncontrol.ErrSimulation(3, 1, environment.ErrPositionOutsideBounds(spatial.NewPoint(0, 3))).Error())
+		assert.EqualError(t, problems[1], missioncontrol.ErrParsingInstructionCommand("MMRMX", 3, 5).Error())
+	})
+}
+
+func Test_Instructions(t *testing.T) {
+	backUp := func(rover roveriface.RoverAPI) error {
+		rover.ChangeHeading(spatial.DirectionLeft)
+		rover.ChangeHeading(spatial.DirectionLeft)
+		err := rover.Move()
+		rover.ChangeHeading(spatial.DirectionRight)
+		rover.ChangeHeading(spatial.DirectionRight)
+		return err
+	}
+	wait := func(roveriface.RoverAPI) error { return nil }
+
+	t.Run("registered instructions can be used alongside the defaults", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl,
+			missioncontrol.Instruction('B', backUp),
+			missioncontrol.Instruction('W', wait),
+		)
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "2 2 N", "MMWBR"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2 3 E"}, report.Statuses())
+		assert.Equal(t, 5, report.Rovers[0].CommandsConsumed)
+		assert.Equal(t, 3, report.Rovers[0].MovesMade)
+	})
+
+	t.Run("errors from registered instructions are subject to the mission's policies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.Instruction('B', backUp))
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "2 1 N", "", "2 2 N", "BM"})
+		assert.NoError(t, err)
+		assert.Equal(t, "2 3 N", report.Rovers[1].String())
+		assert.Equal(t, 1, report.Rovers[1].MovesBlocked)
+	})
+
+	t.Run("default instructions can be replaced", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.Instruction('M', wait))
+		stats, err := mission.ExecuteMission([]string{"5 5", "2 2 N", "MMM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2 2 N"}, stats)
+	})
+
+	t.Run("unregistered instructions are parse errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl, missioncontrol.Instruction('B', backUp))
+		_, err := mission.ExecuteMission([]string{"5 5", "2 2 N", "BX"})
+		assert.EqualError(t, err, missioncontrol.ErrParsingInstructionCommand("BX", 3, 2).Error())
+
+		problems := mission.Validate([]string{"5 5", "2 2 N", "BX"})
+		assert.Len(t, problems, 1)
+		assert.EqualError(t, problems[0], missioncontrol.ErrParsingInstructionCommand("BX", 3, 2).Error())
+	})
+}
+
+func Test_TolerantParsing(t *testing.T) {
+	t.Run("whitespace, line endings, comments and blank lines are tolerated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		stats, err := mission.ExecuteMission([]string{
+			"# a mission to explore the plateau",
+			"5  5\r",
+			"",
+			"  1 2\tN   # the first rover",
+			"LMLM LMLM M\r",
+			"\r",
+			"   # the second rover",
+			"3 3 E",
+			"MMRMMRMRRM # done",
+			"",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "5 1 E"}, stats)
+	})
+
+	t.Run("windows line endings are tolerated when streaming", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		input := strings.NewReader("5 5\r\n1 2 N\r\nLMLMLMLMM\r\n3 3 E\r\nMMRMMRMRRM\r\n\r\n")
+		stats := []string{}
+		err := mission.ExecuteMissionStream(input, func(report missioncontrol.RoverReport) {
+			stats = append(stats, report.String())
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "5 1 E"}, stats)
+	})
+
+	t.Run("line numbers and columns refer to the original input", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		_, err := mission.ExecuteMission([]string{"# plateau", "5 5", "", "  1  2   X # rover", "M"})
+		assert.EqualError(t, err, missioncontrol.ErrParsingPositionCommand("  1  2   X", 4, 10).Error())
+
+		_, err = mission.ExecuteMission([]string{"5 5", "1 2 N", "", "# instructions", " L M Q\r"})
+		assert.EqualError(t, err, missioncontrol.ErrParsingInstructionCommand(" L M Q", 5, 6).Error())
+
+		problems := mission.Validate([]string{"5 5", "", "1 2 N", "", "MQ"})
+		assert.Len(t, problems, 1)
+		assert.EqualError(t, problems[0], missioncontrol.ErrParsingInstructionCommand("MQ", 5, 2).Error())
+	})
+
+	t.Run("a rover's instructions may be omitted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		commands := []string{"5 5", "1 1 N", "2 2 E", "obstacle 3 3", "4 4 S", "M", "0 0 W"}
+		stats, err := mission.ExecuteMission(commands)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 1 N", "2 2 E", "4 3 S", "0 0 W"}, stats)
+		assert.Empty(t, mission.Validate(commands))
+
+		problems := mission.Validate([]string{"5 5", "1 1 N", "1 1 E"})
+		assert.Len(t, problems, 1)
+		assert.EqualError(t, problems[0], missioncontrol.ErrSimulation(3, 1, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 1))).Error())
+	})
+
+	t.Run("an empty position is reported at the end of the line", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		_, _, err := mission.PlaceRoverInEnvironment(nil, []string{"   "})
+		assert.EqualError(t, err, missioncontrol.ErrParsingPositionCommand("", 1, 1).Error())
+	})
+}
+
+func Test_Obstacles(t *testing.T) {
+	t.Run("obstacles block rovers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{
+			"5 5",
+			"obstacle 1 4",
+			"1 2 N",
+			"MMM",
+			"obstacle 3 3 crater",
+			"3 1 N",
+			"MMLM",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "2 2 W"}, report.Statuses())
+		assert.Equal(t, 2, report.Rovers[0].MovesBlocked)
+		assert.Equal(t, 1, report.Rovers[1].MovesBlocked)
+	})
+
+	t.Run("a rover cannot launch onto an obstacle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		_, err := mission.ExecuteMission([]string{"5 5", "obstacle 1 2", "1 2 N", "M"})
+		var incompatible *objects.IncompatibleObjectError
+		assert.True(t, errors.As(err, &incompatible))
+	})
+
+	t.Run("obstacle parse errors", func(t *testing.T) {
+		testCases := []struct {
+			command string
+			expErr  error
+		}{
+			{"obstacle", missioncontrol.ErrParsingObstacleCommand("obstacle", 2, 9)},
+			{"obstacle 1", missioncontrol.ErrParsingObstacleCommand("obstacle 1", 2, 11)},
+			{"obstacle x 1", missioncontrol.ErrParsingObstacleCommand("obstacle x 1", 2, 10)},
+			{"obstacle 1 1y", missioncontrol.ErrParsingObstacleCommand("obstacle 1 1y", 2, 13)},
+			{"obstacle 1 1 tree", missioncontrol.ErrParsingObstacleCommand("obstacle 1 1 tree", 2, 14)},
+			{"obstacle 1 1 rock 2", missioncontrol.ErrParsingObstacleCommand("obstacle 1 1 rock 2", 2, 19)},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.command, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mission := newTestMission(ctrl)
+				_, err := mission.ExecuteMission([]string{"5 5", testCase.command, "1 2 N", "M"})
+				assert.EqualError(t, err, testCase.expErr.Error())
+
+				var parseErr *missioncontrol.ParseError
+				assert.True(t, errors.As(err, &parseErr))
+				assert.Equal(t, missioncontrol.LineObstacle, parseErr.Expected)
+			})
+		}
+	})
+
+	t.Run("obstacles outside of the environment are rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		_, err := mission.ExecuteMission([]string{"5 5", "obstacle 6 1", "1 2 N", "M"})
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(6, 1)).Error())
+	})
+
+	t.Run("obstacles cannot be placed on occupied positions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		for _, commands := range [][]string{
+			{"5 5", "1 1 N", "", "obstacle 1 1"},
+			{"5 5", "obstacle 1 1", "obstacle 1 1 crater"},
+		} {
+			_, err := mission.ExecuteMission(commands)
+			assert.EqualError(t, err, (&environment.PositionOccupiedError{Position: spatial.NewPoint(1, 1)}).Error())
+
+			var occupied *environment.PositionOccupiedError
+			if assert.True(t, errors.As(err, &occupied)) {
+				assert.Len(t, occupied.OccupantIDs, 1)
+			}
+		}
+
+		problems := mission.Validate([]string{"5 5", "1 2 N", "M", "obstacle 1 3", "obstacle 1 2"})
+		assert.Len(t, problems, 1)
+		if len(problems) == 1 {
+			assert.EqualError(t, problems[0], missioncontrol.ErrSimulation(4, 1, &environment.PositionOccupiedError{Position: spatial.NewPoint(1, 3)}).Error())
+		}
+	})
+
+	t.Run("obstacles are validated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		problems := mission.Validate([]string{
+			"5 5",
+			"obstacle 1 4",
+			"obstacle 9 9",
+			"obstacle 2 2 tree",
+			"1 2 N",
+			"MM",
+		})
+		expProblems := []error{
+			missioncontrol.ErrSimulation(3, 1, environment.ErrPositionOutsideBounds(spatial.NewPoint(9, 9))),
+			missioncontrol.ErrParsingObstacleCommand("obstacle 2 2 tree", 4, 14),
+			missioncontrol.ErrSimulation(6, 2, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 4))),
+		}
+		assert.Len(t, problems, len(expProblems))
+		for i := range expProblems {
+			assert.EqualError(t, problems[i], expProblems[i].Error())
+		}
+	})
+}
+
+func Test_RetireRover(t *testing.T) {
+	// newNamedRoverMission returns a mission whose rover builder is able to
+	// restore rovers, so the mission names its rovers "rover-1", "rover-2",
+	// etc. in the order that they appear in the mission's commands.
+	newNamedRoverMission := func(ctrl *gomock.Controller) *missioncontrol.Mission {
+		roverBuilder := mock_roveriface.NewMockRoverRestorer(ctrl)
+		roverBuilder.EXPECT().
+			RestoreRover(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			AnyTimes().
+			DoAndReturn(
+				func(id string, h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+					return objects.Rover{}.RestoreRover(id, h, p, env)
+				})
+
+		envBuilder := mock_environmentiface.NewMockEnvironmentBuilder(ctrl)
+		envBuilder.EXPECT().
+			NewEnvironment(gomock.Any()).
+			AnyTimes().
+			DoAndReturn(func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+				return environment.Plateau{}.NewBoundedPlateau(b)
+			})
+
+		return missioncontrol.NewMission(envBuilder, roverBuilder)
+	}
+
+	t.Run("a retired rover no longer blocks other rovers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newNamedRoverMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{
+			"5 5",
+			"1 2 N", "M",
+			"1 1 N", "MM",
+			"retire rover-1 # the first rover has broken down",
+			"0 3 E", "MM",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1 3 N", "1 2 N", "2 3 E"}, report.Statuses())
+		assert.Equal(t, 1, report.Rovers[1].MovesBlocked)
+		assert.Equal(t, 0, report.Rovers[2].MovesBlocked)
+	})
+
+	t.Run("retiring an unknown rover returns an error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newNamedRoverMission(ctrl)
+		_, err := mission.ExecuteMission([]string{"5 5", "obstacle 1 1", "1 2 N", "M", "retire rover-2"})
+		assert.EqualError(t, err, missioncontrol.ErrUnknownRover("rover-2").Error())
+
+		var unknownRover *missioncontrol.UnknownRoverError
+		assert.True(t, errors.As(err, &unknownRover))
+		assert.Equal(t, "rover-2", unknownRover.RoverID)
+	})
+
+	t.Run("retire parse errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newNamedRoverMission(ctrl)
+		_, err := mission.ExecuteMission([]string{"5 5", "retire"})
+		assert.EqualError(t, err, missioncontrol.ErrParsingRetireCommand("retire", 2, 7).Error())
+
+		_, err = mission.ExecuteMission([]string{"5 5", "retire a b"})
+		assert.EqualError(t, err, missioncontrol.ErrParsingRetireCommand("retire a b", 2, 10).Error())
+
+		problems := mission.Validate([]string{"5 5", "1 2 N", "M", "retire rover-1", "retire", "2 2 N", "M"})
+		assert.Len(t, problems, 1)
+		assert.EqualError(t, problems[0], missioncontrol.ErrParsingRetireCommand("retire", 5, 7).Error())
+	})
+
+	t.Run("mission rovers are named in the order they appear", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newNamedRoverMission(ctrl)
+		for i := 0; i < 2; i++ {
+			report, err := mission.ExecuteMissionReport([]string{"5 5", "1 2 N", "M", "3 3 E"})
+			assert.NoError(t, err)
+			assert.Equal(t, "rover-1", report.Rovers[0].ID)
+			assert.Equal(t, "rover-2", report.Rovers[1].ID)
+		}
+	})
+
+	t.Run("validation retires rovers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newNamedRoverMission(ctrl)
+		problems := mission.Validate([]string{
+			"5 5",
+			"1 2 N", "M",
+			"1 1 N", "M",
+			"retire rover-1",
+			"0 3 E", "MM",
+			"retire rover-1",
+			"retire rover-9",
+		})
+		expProblems := []error{
+			missioncontrol.ErrSimulation(9, 1, missioncontrol.ErrUnknownRover("rover-1")),
+			missioncontrol.ErrSimulation(10, 1, missioncontrol.ErrUnknownRover("rover-9")),
+		}
+		assert.Len(t, problems, len(expProblems))
+		for i := range expProblems {
+			if i < len(problems) {
+				assert.EqualError(t, problems[i], expProblems[i].Error())
+			}
+		}
+	})
+}
+
+func Test_Elevation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{
+		{2, 2, 2},
+		{1, 1, 9},
+		{0, 1, 2},
+	})
+	assert.NoError(t, err)
+
+	fixture := testFixture{
+		newEnvironment: func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			plateau, err := environment.Plateau{}.NewBoundedPlateau(b)
+			if err != nil {
+				return nil, err
+			}
+			return plateau, plateau.SetHeightMap(heightMap)
+		},
+		roverOptions: []objects.RoverOption{objects.MaxSlope(2)},
+	}
+
+	t.Run("reports include elevation, and steep moves are blocked", func(t *testing.T) {
+		mission := fixture.newMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"2 2", "0 0 E", "MMLMM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2 0 N"}, report.Statuses())
+
+		rover := report.Rovers[0]
+		assert.Equal(t, 0, rover.StartElevation)
+		assert.Equal(t, 2, rover.FinalElevation)
+		assert.Equal(t, 2, rover.MovesMade)
+		assert.Equal(t, 2, rover.MovesBlocked)
+	})
+
+	t.Run("steep moves follow the collision policy", func(t *testing.T) {
+		mission := fixture.newMission(ctrl, missioncontrol.CollisionPolicy(missioncontrol.PolicyFail))
+		_, err := mission.ExecuteMission([]string{"2 2", "0 0 E", "MMLMM"})
+		assert.EqualError(t, err, objects.ErrRoverSlopeTooSteep(spatial.NewPoint(2, 1), 7, 2).Error())
+	})
+
+	t.Run("validation simulates scratch rovers configured by the validation rover options", func(t *testing.T) {
+		validationFixture := fixture
+		validationFixture.noRovers = true
+		mission := validationFixture.newMission(ctrl, missioncontrol.ValidationRoverOptions(fixture.roverOptions...))
+		problems := mission.Validate([]string{"2 2", "0 0 E", "MMLMM"})
+		assert.Len(t, problems, 2)
+		for i, column := range []int{4, 5} {
+			assert.EqualError(t, problems[i], missioncontrol.ErrSimulation(3, column, objects.ErrRoverSlopeTooSteep(spatial.NewPoint(2, 1), 7, 2)).Error())
+		}
+	})
+}
+
+func Test_Telemetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mission := newTestMission(ctrl)
+	report, err := mission.ExecuteMissionReport([]string{"3 3", "0 0 S", "LMMLM", "1 2 W", "LMLMRM"})
+	assert.NoError(t, err)
+	assert.Len(t, report.Rovers, 2)
+
+	assert.Equal(t, rovertypes.Telemetry{
+		InstructionsExecuted: 5,
+		CellsTraveled:        3,
+		LeftTurns:            2,
+		BlockedMoves:         map[rovertypes.BlockReason]int{},
+	}, report.Rovers[0].Telemetry)
+
+	// The second rover is blocked by the first rover.
+	assert.Equal(t, rovertypes.Telemetry{
+		InstructionsExecuted: 6,
+		CellsTraveled:        2,
+		LeftTurns:            2,
+		RightTurns:           1,
+		BlockedMoves:         map[rovertypes.BlockReason]int{rovertypes.BlockedByObject: 1},
+	}, report.Rovers[1].Telemetry)
+}
+
+func Test_Battery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fixture := testFixture{
+		roverOptions: []objects.RoverOption{objects.Battery(objects.BatteryConfig{
+			Capacity:   3,
+			MoveCost:   1,
+			ChargeRate: 2,
+		})},
+	}
+
+	mission := fixture.newMission(ctrl)
+
+	t.Run("the status includes the remaining energy", func(t *testing.T) {
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "0 0 N", "MMMWM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0 4 N energy=1"}, report.Statuses())
+		assert.True(t, report.Rovers[0].BatteryPowered)
+		assert.Equal(t, 1, report.Rovers[0].FinalEnergy)
+	})
+
+	t.Run("moves a depleted battery cannot power are skipped by default", func(t *testing.T) {
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "0 0 N", "MMMMWM", "1 1 N", "M"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0 4 N energy=1", "1 2 N energy=2"}, report.Statuses())
+		assert.Equal(t, 1, report.Rovers[0].MovesBlocked)
+		assert.Equal(t, 1, report.Rovers[0].Telemetry.BlockedMoves[rovertypes.BlockedByEnergy])
+	})
+
+	t.Run("moves a depleted battery cannot power are subject to the energy policy", func(t *testing.T) {
+		stopMission := fixture.newMission(ctrl, missioncontrol.EnergyPolicy(missioncontrol.PolicyStop))
+		report, err := stopMission.ExecuteMissionReport([]string{"5 5", "0 0 N", "MMMMWM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0 3 N energy=0"}, report.Statuses())
+		assert.Equal(t, 1, report.Rovers[0].MovesBlocked)
+
+		failMission := fixture.newMission(ctrl, missioncontrol.EnergyPolicy(missioncontrol.PolicyFail))
+		_, err = failMission.ExecuteMission([]string{"5 5", "0 0 N", "MMMM"})
+		assert.EqualError(t, err, objects.ErrRoverInsufficientEnergy(1, 0).Error())
+	})
+
+	t.Run("validation simulates the rovers' batteries", func(t *testing.T) {
+		validationFixture := fixture
+		validationFixture.noRovers = true
+		mission := validationFixture.newMission(ctrl, missioncontrol.ValidationRoverOptions(fixture.roverOptions...))
+		problems := mission.Validate([]string{"5 5", "0 0 N", "MMMMWM"})
+		assert.Len(t, problems, 1)
+		if len(problems) == 1 {
+			assert.EqualError(t, problems[0], missioncontrol.ErrSimulation(3, 4, objects.ErrRoverInsufficientEnergy(1, 0)).Error())
+		}
+	})
+
+	t.Run("rovers without a battery simply wait", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stats, err := newTestMission(ctrl).ExecuteMission([]string{"5 5", "0 0 N", "MWWM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0 2 N"}, stats)
+	})
+}
+
+func Test_Reverse(t *testing.T) {
+	t.Run("B moves the rover backward without changing its heading", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "2 2 N", "MMBRBB"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0 3 E"}, report.Statuses())
+		assert.Equal(t, 5, report.Rovers[0].MovesMade)
+	})
+
+	t.Run("reverse moves are subject to the mission's policies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		report, err := mission.ExecuteMissionReport([]string{"5 5", "2 1 N", "L", "2 2 N", "BM"})
+		assert.NoError(t, err)
+		assert.Equal(t, "2 3 N", report.Rovers[1].String())
+		assert.Equal(t, 1, report.Rovers[1].MovesBlocked)
+
+		_, err = mission.ExecuteMission([]string{"5 5", "0 0 N", "B"})
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(0, -1)).Error())
+	})
+}
+
+func Test_TorusMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fixture := testFixture{
+		newEnvironment: func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			return environment.Torus{}.NewBoundedTorus(b)
+		},
+	}
+
+	mission := fixture.newMission(ctrl)
+	stats, err := mission.ExecuteMission([]string{"5 5", "1 4 N", "MMM", "5 1 E", "MRMLM", "0 4 W", "M"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1 1 N", "1 0 E", "5 4 W"}, stats)
+}
+
+func Test_MaskedMission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// A triangular plateau, whose hypotenuse runs from (0,0) to (4,4).
+	fixture := testFixture{
+		newEnvironment: func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			mask, err := environment.Mask{}.NewPolygonMask([]spatial.Point{
+				spatial.NewPoint(0, 0),
+				spatial.NewPoint(4, 0),
+				spatial.NewPoint(4, 4),
+			})
+			if err != nil {
+				return nil, err
+			}
+			plateau, err := environment.Plateau{}.NewBoundedPlateau(b)
+			if err != nil {
+				return nil, err
+			}
+			return plateau, plateau.SetMask(mask)
+		},
+	}
+
+	mission := fixture.newMission(ctrl, missioncontrol.BoundaryPolicy(missioncontrol.PolicySkip))
+	stats, err := mission.ExecuteMission([]string{"4 4", "1 0 N", "MMLMRM", "3 0 E", "MLMMMM"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1 1 N", "4 4 N"}, stats)
+
+	_, err = mission.ExecuteMission([]string{"4 4", "0 4 N", "M"})
+	assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(0, 4)).Error())
+}
+
+func Test_EnvironmentBounds(t *testing.T) {
+	t.Run("environments can span negative space", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mission := newTestMission(ctrl)
+		stats, err := mission.ExecuteMission([]string{"-10 -10 10 10", "-10 -10 S", "LMMLM", "0 0 W", "MM"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-8 -9 N", "-2 0 W"}, stats)
+
+		_, err = mission.ExecuteMission([]string{"-10 -10 10 10", "-10 -10 S", "M"})
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(-10, -11)).Error())
+	})
+
+	t.Run("negative dimensions and inverted bounds return an error", func(t *testing.T) {
+		testCases := []struct {
+			command string
+			expErr  error
+		}{
+			{"-1 5", environment.ErrNegativeDimensions(spatial.NewPoint(-1, 5))},
+			{"5 -1", environment.ErrNegativeDimensions(spatial.NewPoint(5, -1))},
+			{"5 5 4 10", environment.ErrInvertedBounds(spatial.NewRectangle(spatial.NewPoint(5, 5), spatial.NewPoint(4, 10)))},
+		}
+		for _, testCase := range testCases {
+			t.Run(testCase.command, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mission := newTestMission(ctrl)
+				_, err := mission.ExecuteMission([]string{testCase.command, "1 2 N", "M"})
+				assert.EqualError(t, err, testCase.expErr.Error())
+				assert.IsType(t, testCase.expErr, err)
+
+				problems := mission.Validate([]string{testCase.command, "1 2 N", "MQ"})
+				expProblems := []error{
+					missioncontrol.ErrSimulation(1, 1, testCase.expErr),
+					missioncontrol.ErrParsingInstructionCommand("MQ", 3, 2),
+				}
+				assert.Len(t, problems, len(expProblems))
+				for i := range expProblems {
+					assert.EqualError(t, problems[i], expProblems[i].Error())
+				}
+			})
+		}
+	})
+}
+
+// newTestMission returns a mission whose builders construct real plateaus and
+// rovers.
+func newTestMission(ctrl *gomock.Controller, options ...missioncontrol.Option) *missioncontrol.Mission {
+	return testFixture{}.newMission(ctrl, options...)
+}
+
+// testFixture describes the environments and rovers that are constructed by a
+// test mission's builders.
+type testFixture struct {
+	// newEnvironment constructs the mission's environment. Bounded plateaus
+	// are constructed if newEnvironment is nil.
+	newEnvironment func(spatial.Rectangle) (environmentiface.Environmenter, error)
+
+	// roverOptions are applied to every rover that the mission launches.
+	roverOptions []objects.RoverOption
+
+	// noRovers causes the mission's rover builder to expect no calls.
+	noRovers bool
+}
+
+// newMission returns a mission whose builders construct environments and
+// rovers as described by the fixture.
+func (f testFixture) newMission(ctrl *gomock.Controller, options ...missioncontrol.Option) *missioncontrol.Mission {
+	roverBuilder := mock_roveriface.NewMockRoverBuilder(ctrl)
+	if !f.noRovers {
+		roverBuilder.EXPECT().
+			LaunchRover(gomock.Any(), gomock.Any(), gomock.Any()).
+			AnyTimes().
+			DoAndReturn(
+				func(h spatial.Heading, p spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+					return objects.Rover{}.LaunchRover(h, p, env, f.roverOptions...)
+				})
+	}
+
+	newEnvironment := f.newEnvironment
+	if newEnvironment == nil {
+		newEnvironment = func(b spatial.Rectangle) (environmentiface.Environmenter, error) {
+			return environment.Plateau{}.NewBoundedPlateau(b)
+		}
+	}
+	envBuilder := mock_environmentiface.NewMockEnvironmentBuilder(ctrl)
+	envBuilder.EXPECT().
+		NewEnvironment(gomock.Any()).
+		AnyTimes().
+		DoAndReturn(newEnvironment)
+
+	return missioncontrol.NewMission(envBuilder, roverBuilder, options...)
+}
@@ -1,14 +1,175 @@
 package missioncontrol
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
+
+// A LineKind identifies a kind of line within a mission's commands.
+type LineKind int
+
+// Kinds of lines that can appear within a mission's commands.
+const (
+	LinePlateau LineKind = iota
+	LinePosition
+	LineInstructions
+	LineObstacle
+	LineRetire
+)
+
+// String returns a human readable name for the kind of line.
+func (k LineKind) String() string {
+	switch k {
+	case LinePlateau:
+		return "plateau"
+	case LinePosition:
+		return "position"
+	case LineInstructions:
+		return "instructions"
+	case LineObstacle:
+		return "obstacle"
+	case LineRetire:
+		return "retire"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseError describes a command that could not be parsed.
+type ParseError struct {
+	// Line is the 1-based line number of the offending command.
+	Line int
+
+	// Column is the 1-based column of the offending character within the
+	// command. If the command ended prematurely, the column immediately
+	// follows the end of the command.
+	Column int
+
+	// Expected is the kind of line that was expected.
+	Expected LineKind
+
+	// Command is the text of the offending command.
+	Command string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("error parsing %v command at line %v, column %v: '%v'", e.Expected, e.Line, e.Column, e.Command)
+}
 
 // ErrParsingEnvironmentCommand occurs when an environment command is malformed.
-func ErrParsingEnvironmentCommand(cmd string) error {
-	return fmt.Errorf("error parsing environment command '%v'", cmd)
+func ErrParsingEnvironmentCommand(cmd string, line, column int) error {
+	return &ParseError{Line: line, Column: column, Expected: LinePlateau, Command: cmd}
+}
+
+// ErrParsingPositionCommand occurs when a rover's position command is
+// malformed.
+func ErrParsingPositionCommand(cmd string, line, column int) error {
+	return &ParseError{Line: line, Column: column, Expected: LinePosition, Command: cmd}
 }
 
 // ErrParsingRoverCommand is returned if the commands supplied to the rover
-// are sufficient to control the rover.
+// are insufficient to control the rover.
+//
+// Deprecated: Malformed rover commands are reported as a *ParseError. Use
+// ErrParsingPositionCommand or ErrParsingInstructionCommand instead.
 func ErrParsingRoverCommand(cmd string) error {
 	return fmt.Errorf("the supplied commands are insufficient to move a rover. commands: '%v'", cmd)
 }
+
+// ErrParsingObstacleCommand occurs when an obstacle command is malformed.
+func ErrParsingObstacleCommand(cmd string, line, column int) error {
+	return &ParseError{Line: line, Column: column, Expected: LineObstacle, Command: cmd}
+}
+
+// ErrParsingRetireCommand occurs when a retire command is malformed.
+func ErrParsingRetireCommand(cmd string, line, column int) error {
+	return &ParseError{Line: line, Column: column, Expected: LineRetire, Command: cmd}
+}
+
+// ErrParsingInstructionCommand occurs when a rover's navigation instructions
+// are malformed or missing.
+func ErrParsingInstructionCommand(cmd string, line, column int) error {
+	return &ParseError{Line: line, Column: column, Expected: LineInstructions, Command: cmd}
+}
+
+// RoverFailuresError is returned if one or more rovers failed during a mission
+// that was configured to continue on error.
+type RoverFailuresError struct {
+	Failures []RoverFailure
+}
+
+// ErrRoverFailures constructs a RoverFailuresError from a list of failures.
+func ErrRoverFailures(failures []RoverFailure) error {
+	return &RoverFailuresError{Failures: failures}
+}
+
+func (e *RoverFailuresError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, fmt.Sprintf("%v: %v", missionRoverID(failure.Index), failure.Err))
+	}
+	return fmt.Sprintf("%v rover(s) failed during the mission: %v", len(e.Failures), strings.Join(messages, "; "))
+}
+
+// BudgetExhaustedError is returned if a rover is supplied more navigation
+// instructions than the mission's StepBudget allows.
+type BudgetExhaustedError struct {
+	// Budget is the number of instructions that the rover was allowed.
+	Budget int
+
+	// Report describes the rover's state when the budget was exhausted.
+	Report RoverReport
+}
+
+// ErrBudgetExhausted constructs a BudgetExhaustedError.
+func ErrBudgetExhausted(budget int, report RoverReport) error {
+	return &BudgetExhaustedError{Budget: budget, Report: report}
+}
+
+func (e *BudgetExhaustedError) Error() string {
+	return fmt.Sprintf("rover '%v' exhausted its budget of %v instructions at '%v'", e.Report.ID, e.Budget, e.Report)
+}
+
+// SimulationError describes a problem that is predicted to occur if a mission
+// is executed. See Mission.Validate.
+type SimulationError struct {
+	// Line is the 1-based line number of the command that would fail.
+	Line int
+
+	// Column is the 1-based column of the instruction that would fail within
+	// the command.
+	Column int
+
+	// Err is the error that the command is predicted to produce.
+	Err error
+}
+
+// ErrSimulation constructs a SimulationError.
+func ErrSimulation(line, column int, err error) error {
+	return &SimulationError{Line: line, Column: column, Err: err}
+}
+
+func (e *SimulationError) Error() string {
+	return fmt.Sprintf("line %v, column %v: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the error that the command is predicted to produce.
+func (e *SimulationError) Unwrap() error {
+	return e.Err
+}
+
+// UnknownRoverError occurs if a command refers to a rover that is not present
+// within the environment.
+type UnknownRoverError struct {
+	RoverID string
+}
+
+// ErrUnknownRover occurs if a command refers to a rover that is not present
+// within the environment.
+func ErrUnknownRover(roverID string) error {
+	return &UnknownRoverError{RoverID: roverID}
+}
+
+func (e *UnknownRoverError) Error() string {
+	return fmt.Sprintf("no rover with ID '%v' is present within the environment", e.RoverID)
+}
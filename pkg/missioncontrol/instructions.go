@@ -0,0 +1,55 @@
+package missioncontrol
+
+import (
+	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// An InstructionFunc carries out a single navigation instruction on behalf of
+// a rover.
+//
+// Errors returned from an InstructionFunc are handled in the same way as
+// errors returned from a rover's Move method. In particular, errors that
+// indicate that the rover was blocked are subject to the mission's
+// CollisionPolicy, BoundaryPolicy and EnergyPolicy.
+type InstructionFunc func(rover roveriface.RoverAPI) error
+
+// defaultInstructions returns the instructions that every mission recognizes
+// unless they are overridden.
+func defaultInstructions() map[rune]InstructionFunc {
+	return map[rune]InstructionFunc{
+		'L': turn(spatial.DirectionLeft),
+		'R': turn(spatial.DirectionRight),
+		'M': move,
+		'B': reverse,
+		'W': charge,
+	}
+}
+
+// turn returns an InstructionFunc that changes a rover's heading in the
+// specified direction.
+func turn(direction spatial.Direction) InstructionFunc {
+	return func(rover roveriface.RoverAPI) error {
+		return rover.ChangeHeading(direction)
+	}
+}
+
+// move is an InstructionFunc that moves a rover forward.
+func move(rover roveriface.RoverAPI) error {
+	return rover.Move()
+}
+
+// reverse is an InstructionFunc that moves a rover backward, without changing
+// its heading.
+func reverse(rover roveriface.RoverAPI) error {
+	return rover.Reverse()
+}
+
+// charge is an InstructionFunc that has a rover wait in place while it charges
+// its battery. Rovers that are not BatteryPowered simply wait.
+func charge(rover roveriface.RoverAPI) error {
+	if powered, ok := rover.(roveriface.BatteryPowered); ok {
+		powered.Charge()
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package missioncontrol
+
+import "github.com/jecolasurdo/marsrover/pkg/objects"
+
+// An Option configures optional behavior of a Mission.
+type Option func(*Mission)
+
+// A Policy determines how a mission responds when a rover's move is blocked.
+type Policy int
+
+// Policies that can be applied to blocked moves.
+const (
+	// PolicyUnknown represents an unrecognized policy.
+	PolicyUnknown Policy = -1
+
+	// PolicySkip skips the blocked move, and carries on with the rover's
+	// remaining instructions.
+	PolicySkip Policy = 0
+
+	// PolicyStop skips the blocked move, and ignores the rover's remaining
+	// instructions.
+	PolicyStop Policy = 1
+
+	// PolicyFail fails the mission.
+	PolicyFail Policy = 2
+)
+
+// PolicyFromString converts a policy string ("skip", "stop", "fail") to a
+// Policy. If the supplied value cannot be mapped to a policy, this function
+// will return PolicyUnknown.
+func PolicyFromString(p string) Policy {
+	switch p {
+	case "skip":
+		return PolicySkip
+	case "stop":
+		return PolicyStop
+	case "fail":
+		return PolicyFail
+	default:
+		return PolicyUnknown
+	}
+}
+
+// ContinueOnError configures a mission to carry on deploying the remaining
+// rovers when a rover fails, rather than halting the mission. Each failure is
+// recorded in the mission's report. See ExecuteMissionReport.
+func ContinueOnError() Option {
+	return func(m *Mission) {
+		m.continueOnError = true
+	}
+}
+
+// StepBudget limits the number of navigation instructions that each rover may
+// execute. If a rover is supplied more instructions than the budget allows,
+// navigation halts once the budget has been exhausted, and a
+// *BudgetExhaustedError is returned. A budget of zero (the default) allows an
+// unlimited number of instructions.
+func StepBudget(steps int) Option {
+	return func(m *Mission) {
+		m.stepBudget = steps
+	}
+}
+
+// CollisionPolicy determines how the mission responds when a rover's move is
+// blocked by another object, or by terrain that is too steep for the rover to
+// climb or descend. By default, blocked moves are skipped (PolicySkip).
+func CollisionPolicy(policy Policy) Option {
+	return func(m *Mission) {
+		m.collisionPolicy = policy
+	}
+}
+
+// BoundaryPolicy determines how the mission responds when a rover's move is
+// blocked by the bounds of the environment. By default, the mission fails
+// (PolicyFail).
+func BoundaryPolicy(policy Policy) Option {
+	return func(m *Mission) {
+		m.boundaryPolicy = policy
+	}
+}
+
+// EnergyPolicy determines how the mission responds when a battery powered
+// rover has too little energy to carry out a move or a turn. By default, the
+// move or turn is skipped (PolicySkip), so a rover can carry on once it has
+// charged its battery.
+func EnergyPolicy(policy Policy) Option {
+	return func(m *Mission) {
+		m.energyPolicy = policy
+	}
+}
+
+// ValidationRoverOptions configures the scratch rovers that Validate simulates
+// in place of the rovers that the mission's RoverBuilder would build. Supplying
+// the same options that the RoverBuilder applies (such as a MaxSlope or a
+// Battery) allows validation to predict the problems that those options would
+// cause. By default, scratch rovers are launched without any options.
+func ValidationRoverOptions(options ...objects.RoverOption) Option {
+	return func(m *Mission) {
+		m.validationRoverOptions = options
+	}
+}
+
+// Instruction registers a navigation instruction, so that each occurrence of
+// token within a rover's navigation command is carried out by fn. By default,
+// a mission recognizes L and R (which turn the rover left and right), M and B
+// (which move the rover forward and backward) and W (which has the rover wait
+// while it charges its battery). Registering one of those tokens replaces its
+// default behavior.
+func Instruction(token rune, fn InstructionFunc) Option {
+	return func(m *Mission) {
+		m.instructions[token] = fn
+	}
+}
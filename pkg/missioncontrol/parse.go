@@ -0,0 +1,289 @@
+package missioncontrol
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// A field is a single value within a command, along with the 1-based column at
+// which the value begins.
+type field struct {
+	text   string
+	column int
+}
+
+// commentPrefix marks the beginning of a comment within a command. Comments
+// extend to the end of the line.
+const commentPrefix = "#"
+
+// cleanCommand removes any comment, along with any trailing whitespace
+// (including carriage returns), from a command. Leading whitespace is retained
+// so that the columns within the cleaned command match those of the original.
+func cleanCommand(command string) string {
+	if i := strings.Index(command, commentPrefix); i >= 0 {
+		command = command[:i]
+	}
+	return strings.TrimRightFunc(command, unicode.IsSpace)
+}
+
+// isBlank returns true if a command contains nothing but whitespace and
+// comments.
+func isBlank(command string) bool {
+	return strings.TrimSpace(cleanCommand(command)) == ""
+}
+
+// splitFields splits a command into its whitespace delimited fields.
+func splitFields(command string) []field {
+	fields := []field{}
+	column := 0
+	start := -1
+	var text []rune
+	for _, r := range command {
+		column++
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				fields = append(fields, field{text: string(text), column: start})
+				start, text = -1, nil
+			}
+			continue
+		}
+		if start < 0 {
+			start = column
+		}
+		text = append(text, r)
+	}
+	if start >= 0 {
+		fields = append(fields, field{text: string(text), column: start})
+	}
+	return fields
+}
+
+// endColumn returns the column immediately following the last character of a
+// command.
+func endColumn(command string) int {
+	return utf8.RuneCountInString(command) + 1
+}
+
+// parseInt attempts to parse a field as an integer. If the field is not a valid
+// integer, then the column of the first offending character is returned along
+// with false.
+func parseInt(f field) (int, int, bool) {
+	value, err := strconv.Atoi(f.text)
+	if err == nil {
+		return value, 0, true
+	}
+
+	for i, r := range []rune(f.text) {
+		if r >= '0' && r <= '9' {
+			continue
+		}
+		if i == 0 && (r == '-' || r == '+') {
+			continue
+		}
+		return 0, f.column + i, false
+	}
+	return 0, f.column, false
+}
+
+// parseEnvironmentCommand parses an environment command, where line is the
+// line number of the command. The command is either of the form 'x y', which
+// describes an environment spanning from (0,0) to (x,y), or of the form
+// 'minX minY maxX maxY', which describes an environment spanning from
+// (minX,minY) to (maxX,maxY).
+//
+// If either of the dimensions of the 'x y' form is negative, an
+// *environment.NegativeDimensionsError is returned.
+func parseEnvironmentCommand(command string, line int) (spatial.Rectangle, error) {
+	command = cleanCommand(command)
+	coords := splitFields(command)
+
+	values := []int{}
+	for i, coord := range coords {
+		if i == 4 {
+			break
+		}
+		value, column, ok := parseInt(coord)
+		if !ok {
+			return spatial.Rectangle{}, ErrParsingEnvironmentCommand(command, line, column)
+		}
+		values = append(values, value)
+	}
+
+	switch {
+	case len(coords) == 2:
+		dimensions := spatial.NewPoint(values[0], values[1])
+		if dimensions.X < 0 || dimensions.Y < 0 {
+			return spatial.Rectangle{}, environment.ErrNegativeDimensions(dimensions)
+		}
+		return spatial.NewRectangle(spatial.Point{}, dimensions), nil
+	case len(coords) == 4:
+		return spatial.NewRectangle(
+			spatial.NewPoint(values[0], values[1]),
+			spatial.NewPoint(values[2], values[3]),
+		), nil
+	case len(coords) > 4:
+		return spatial.Rectangle{}, ErrParsingEnvironmentCommand(command, line, coords[4].column)
+	default:
+		return spatial.Rectangle{}, ErrParsingEnvironmentCommand(command, line, endColumn(command))
+	}
+}
+
+// parsePositionCommand parses a rover's position command of the form 'x y h',
+// where line is the line number of the command.
+func parsePositionCommand(command string, line int) (spatial.Point, spatial.Heading, error) {
+	command = cleanCommand(command)
+	fields := splitFields(command)
+	if len(fields) < 1 {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, endColumn(command))
+	}
+
+	x, column, ok := parseInt(fields[0])
+	if !ok {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, column)
+	}
+
+	if len(fields) < 2 {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, endColumn(command))
+	}
+
+	y, column, ok := parseInt(fields[1])
+	if !ok {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, column)
+	}
+
+	if len(fields) < 3 {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, endColumn(command))
+	}
+
+	heading := spatial.HeadingFromString(fields[2].text)
+	if heading == spatial.HeadingUnknown {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, fields[2].column)
+	}
+
+	if len(fields) > 3 {
+		return spatial.Point{}, spatial.HeadingUnknown, ErrParsingPositionCommand(command, line, fields[3].column)
+	}
+
+	return spatial.NewPoint(x, y), heading, nil
+}
+
+// obstacleKeyword is the first field of an obstacle command.
+const obstacleKeyword = "obstacle"
+
+// isPositionCommand returns true if the command is a well formed position
+// command.
+func isPositionCommand(command string) bool {
+	_, _, err := parsePositionCommand(command, 0)
+	return err == nil
+}
+
+// isInstructionCommand returns true if the command, when it follows a rover's
+// position command, supplies the rover's navigation instructions. Otherwise,
+// the command begins something else (another rover, an obstacle or a
+// retirement), and the rover has no instructions.
+func isInstructionCommand(command string) bool {
+	return !isPositionCommand(command) && !isObstacleCommand(command) && !isRetireCommand(command)
+}
+
+// isObstacleCommand returns true if the command describes an obstacle.
+func isObstacleCommand(command string) bool {
+	fields := splitFields(cleanCommand(command))
+	return len(fields) > 0 && fields[0].text == obstacleKeyword
+}
+
+// parseObstacleCommand parses an obstacle command of the form
+// 'obstacle x y [kind]', where line is the line number of the command. If the
+// kind is omitted, the obstacle is assumed to be a rock.
+func parseObstacleCommand(command string, line int) (spatial.Point, environment.ObstacleKind, error) {
+	command = cleanCommand(command)
+	fields := splitFields(command)
+	if len(fields) < 1 || fields[0].text != obstacleKeyword {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, 1)
+	}
+
+	if len(fields) < 2 {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, endColumn(command))
+	}
+
+	x, column, ok := parseInt(fields[1])
+	if !ok {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, column)
+	}
+
+	if len(fields) < 3 {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, endColumn(command))
+	}
+
+	y, column, ok := parseInt(fields[2])
+	if !ok {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, column)
+	}
+
+	kind := environment.ObstacleRock
+	if len(fields) > 3 {
+		kind = environment.ObstacleKindFromString(fields[3].text)
+		if kind == environment.ObstacleUnknown {
+			return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, fields[3].column)
+		}
+	}
+
+	if len(fields) > 4 {
+		return spatial.Point{}, environment.ObstacleUnknown, ErrParsingObstacleCommand(command, line, fields[4].column)
+	}
+
+	return spatial.NewPoint(x, y), kind, nil
+}
+
+// retireKeyword is the first field of a retire command.
+const retireKeyword = "retire"
+
+// isRetireCommand returns true if the command retires a rover.
+func isRetireCommand(command string) bool {
+	fields := splitFields(cleanCommand(command))
+	return len(fields) > 0 && fields[0].text == retireKeyword
+}
+
+// parseRetireCommand parses a retire command of the form 'retire id', where
+// line is the line number of the command, and returns the rover's ID.
+func parseRetireCommand(command string, line int) (string, error) {
+	command = cleanCommand(command)
+	fields := splitFields(command)
+	if len(fields) < 1 || fields[0].text != retireKeyword {
+		return "", ErrParsingRetireCommand(command, line, 1)
+	}
+
+	if len(fields) < 2 {
+		return "", ErrParsingRetireCommand(command, line, endColumn(command))
+	}
+
+	if len(fields) > 2 {
+		return "", ErrParsingRetireCommand(command, line, fields[2].column)
+	}
+
+	return fields[1].text, nil
+}
+
+// A token is a single instruction within a navigation command, along with the
+// 1-based column at which the instruction appears.
+type token struct {
+	instruction rune
+	column      int
+}
+
+// splitInstructions splits a navigation command into its instructions.
+// Whitespace and comments within the command are ignored.
+func splitInstructions(command string) []token {
+	tokens := []token{}
+	for i, r := range []rune(cleanCommand(command)) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		tokens = append(tokens, token{instruction: r, column: i + 1})
+	}
+	return tokens
+}
@@ -0,0 +1,99 @@
+package missioncontrol
+
+import (
+	"fmt"
+
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// A MissionReport describes the outcome of a mission.
+type MissionReport struct {
+	// Rovers contains a report for each rover that was deployed during the
+	// mission, in the order that the rovers were deployed.
+	Rovers []RoverReport
+
+	// Failures contains a record of each rover that failed during the
+	// mission. Failures are only recorded if the mission was configured with
+	// ContinueOnError.
+	Failures []RoverFailure
+}
+
+// Statuses renders the status of each rover in the report. See
+// RoverReport.String for the format of each status.
+func (r *MissionReport) Statuses() []string {
+	statuses := make([]string, 0, len(r.Rovers))
+	for _, rover := range r.Rovers {
+		statuses = append(statuses, rover.String())
+	}
+	return statuses
+}
+
+// A RoverReport describes the outcome of navigating a single rover.
+type RoverReport struct {
+	// ID is the ID of the rover.
+	ID string
+
+	// StartPosition, StartHeading and StartElevation describe the rover's
+	// state before it was navigated.
+	StartPosition  spatial.Point
+	StartHeading   spatial.Heading
+	StartElevation int
+
+	// FinalPosition, FinalHeading and FinalElevation describe the rover's
+	// state after it was navigated.
+	FinalPosition  spatial.Point
+	FinalHeading   spatial.Heading
+	FinalElevation int
+
+	// CommandsConsumed is the number of navigation commands (such as L, R, or
+	// M) that were processed for the rover.
+	CommandsConsumed int
+
+	// MovesMade is the number of moves that changed the rover's position.
+	MovesMade int
+
+	// MovesBlocked is the number of moves that were skipped because the rover
+	// was blocked (by another object, by terrain that was too steep, by the
+	// bounds of the environment, or by a lack of energy). Turns that the
+	// rover's battery had too little energy for are also counted.
+	MovesBlocked int
+
+	// BatteryPowered is true if the rover has a battery, in which case
+	// FinalEnergy is the energy that remained within the battery after the
+	// rover was navigated.
+	BatteryPowered bool
+	FinalEnergy    int
+
+	// Telemetry is the rover's own record of its activity, as of the end of
+	// its navigation. Telemetry is only recorded for rovers that are
+	// TelemetryReporters (see roveriface), and is otherwise empty.
+	Telemetry rovertypes.Telemetry
+}
+
+// String renders the rover's final state as a single string with three values
+// as follows: "{x coordinate} {y coordinate} {heading}"
+//
+// If the rover is battery powered, the remaining energy is appended as a
+// labelled value: "{x coordinate} {y coordinate} {heading} energy={energy}"
+func (r RoverReport) String() string {
+	heading := spatial.HeadingToString(r.FinalHeading)
+	if r.BatteryPowered {
+		return fmt.Sprintf("%v %v %v energy=%v", r.FinalPosition.X, r.FinalPosition.Y, heading, r.FinalEnergy)
+	}
+	return fmt.Sprintf("%v %v %v", r.FinalPosition.X, r.FinalPosition.Y, heading)
+}
+
+// A RoverFailure records a rover that could not be deployed or navigated.
+type RoverFailure struct {
+	// Index is the zero-based position of the rover's commands within the
+	// mission's rover commands. The rover is named by the ID it was given
+	// during the mission, so the rover at Index 1 is reported as "rover-2".
+	Index int
+
+	// Commands are the commands that were supplied for the rover.
+	Commands []string
+
+	// Err is the error that caused the rover to fail.
+	Err error
+}
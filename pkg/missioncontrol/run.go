@@ -0,0 +1,151 @@
+package missioncontrol
+
+import (
+	"context"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+)
+
+// A numberedCommand is a command along with its 1-based line number.
+type numberedCommand struct {
+	text string
+	line int
+}
+
+// A missionRun tracks the progress of a mission whose commands are supplied
+// one line at a time.
+type missionRun struct {
+	mission     *Mission
+	ctx         context.Context
+	onRover     func(RoverReport)
+	env         environmentiface.Environmenter
+	established bool
+	line        int
+	pending     []numberedCommand
+	index       int
+	failures    []RoverFailure
+}
+
+// newRun begins a new run of the mission, which halts if ctx is cancelled. The
+// onRover function (if not nil) is called with the report of each rover as
+// soon as that rover has been navigated.
+func (m *Mission) newRun(ctx context.Context, onRover func(RoverReport)) *missionRun {
+	return &missionRun{
+		mission: m,
+		ctx:     ctx,
+		onRover: onRover,
+	}
+}
+
+// feed supplies the next command to the run.
+//
+// Blank commands (those containing nothing but whitespace and comments) are
+// ignored. The first remaining command establishes the environment. Each
+// subsequent obstacle or retire command is carried out immediately, and each
+// subsequent pair of rover commands deploys and navigates a rover as soon as
+// both of the rover's commands have been supplied. A rover whose position is
+// followed by anything other than instructions is deployed without any
+// instructions.
+func (r *missionRun) feed(command string) error {
+	if err := r.ctx.Err(); err != nil {
+		return err
+	}
+
+	r.line++
+	if isBlank(command) {
+		return nil
+	}
+
+	if !r.established {
+		env, _, err := r.mission.establishEnvironment([]string{command}, r.line)
+		if err != nil {
+			return err
+		}
+		r.env = env
+		r.established = true
+		return nil
+	}
+
+	if len(r.pending) > 0 && !isInstructionCommand(command) {
+		err := r.deployPending()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(r.pending) == 0 && isObstacleCommand(command) {
+		_, err := r.mission.placeObstacleInEnvironment(r.env, []string{command}, r.line)
+		return err
+	}
+
+	if len(r.pending) == 0 && isRetireCommand(command) {
+		_, err := r.mission.retireRover(r.env, []string{command}, r.line)
+		return err
+	}
+
+	r.pending = append(r.pending, numberedCommand{text: command, line: r.line})
+	if len(r.pending) < roverCommandCount {
+		return nil
+	}
+	return r.deployPending()
+}
+
+// finish signals that no more commands will be supplied to the run. If the
+// last rover's instructions were not supplied, the rover is deployed without
+// any instructions.
+func (r *missionRun) finish() error {
+	if len(r.pending) == 0 {
+		return nil
+	}
+	return r.deployPending()
+}
+
+// deployPending deploys and navigates a rover according to the pending
+// commands.
+func (r *missionRun) deployPending() error {
+	pending := r.pending
+	index := r.index
+	r.pending = nil
+	r.index++
+
+	report, err := r.deployAndNavigateRover(pending, missionRoverID(index))
+	if err != nil {
+		if !r.mission.continueOnError {
+			return err
+		}
+		commands := []string{}
+		for _, command := range pending {
+			commands = append(commands, command.text)
+		}
+		r.failures = append(r.failures, RoverFailure{
+			Index:    index,
+			Commands: commands,
+			Err:      err,
+		})
+		return nil
+	}
+
+	if r.onRover != nil {
+		r.onRover(*report)
+	}
+	return nil
+}
+
+// deployAndNavigateRover deploys a rover with the specified ID according to the
+// first command, and navigates the rover according to the second command (if
+// any).
+func (r *missionRun) deployAndNavigateRover(commands []numberedCommand, id string) (*RoverReport, error) {
+	position := commands[0]
+	instructions := numberedCommand{line: position.line + 1}
+	if len(commands) > 1 {
+		instructions = commands[1]
+	}
+
+	rover, _, err := r.mission.placeRoverInEnvironment(r.env, []string{position.text}, position.line, id)
+	if err != nil {
+		return nil, err
+	}
+
+	report, _, err := r.mission.navigateRover(r.ctx, rover, []string{instructions.text}, instructions.line)
+	return report, err
+}
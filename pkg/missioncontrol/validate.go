@@ -0,0 +1,234 @@
+package missioncontrol
+
+import (
+	"errors"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects"
+	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// Validate checks a mission's commands without executing the mission, and
+// returns every problem that it finds. An empty list indicates that no
+// problems were found.
+//
+// Each command is checked for syntax errors, which are reported as a
+// *ParseError. Any obstacles are placed, and the rovers are then simulated,
+// within a scratch environment constructed by the mission's EnvironmentBuilder.
+// The mission's RoverBuilder is never used; each rover is simulated as an
+// objects.Rover, configured by any ValidationRoverOptions. Any environment,
+// placement or launch that would fail (such as a launch outside of the
+// environment), and any move that would be blocked (for instance, by another
+// object or by the bounds of the environment), is reported as a
+// *SimulationError. Retirements are carried out
+// within the scratch environment, so a retire command that refers to an
+// unknown rover is also reported as a *SimulationError.
+//
+// If the mission has a StepBudget, each rover that would exhaust its budget is
+// reported as a *SimulationError wrapping a *BudgetExhaustedError.
+//
+// Unlike ExecuteMission, validation does not stop at the first problem. Blocked
+// moves are skipped, unless the mission's policy for the blocked move is
+// PolicyStop, in which case the rover's remaining instructions are only checked
+// for syntax errors.
+func (m *Mission) Validate(commands []string) []error {
+	problems := []error{}
+	numberedCommands := []numberedCommand{}
+	for i, command := range commands {
+		if !isBlank(command) {
+			numberedCommands = append(numberedCommands, numberedCommand{text: command, line: i + 1})
+		}
+	}
+	if len(numberedCommands) == 0 {
+		return problems
+	}
+
+	var env environmentiface.Environmenter
+	bounds, err := parseEnvironmentCommand(numberedCommands[0].text, numberedCommands[0].line)
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		problems = append(problems, err)
+	} else if err != nil {
+		problems = append(problems, ErrSimulation(numberedCommands[0].line, 1, err))
+	} else {
+		env, err = m.envBuilder.NewEnvironment(bounds)
+		if err != nil {
+			problems = append(problems, ErrSimulation(numberedCommands[0].line, 1, err))
+			env = nil
+		}
+	}
+
+	var position *numberedCommand
+	index := 0
+	for _, command := range numberedCommands[1:] {
+		if position != nil && isInstructionCommand(command.text) {
+			problems = append(problems, m.validateRover(env, missionRoverID(index), *position, command)...)
+			position = nil
+			index++
+			continue
+		}
+
+		if position != nil {
+			problems = append(problems, m.validateRover(env, missionRoverID(index), *position, numberedCommand{line: position.line + 1})...)
+			position = nil
+			index++
+		}
+
+		if isObstacleCommand(command.text) {
+			problems = append(problems, m.validateObstacle(env, command)...)
+			continue
+		}
+
+		if isRetireCommand(command.text) {
+			problems = append(problems, m.validateRetirement(env, command)...)
+			continue
+		}
+
+		command := command
+		position = &command
+	}
+
+	if position != nil {
+		problems = append(problems, m.validateRover(env, missionRoverID(index), *position, numberedCommand{line: position.line + 1})...)
+	}
+
+	return problems
+}
+
+// validateObstacle checks an obstacle command, and places the obstacle within
+// the scratch environment. If env is nil, the command is only checked for
+// syntax errors.
+func (m *Mission) validateObstacle(env environmentiface.Environmenter, command numberedCommand) []error {
+	position, kind, err := parseObstacleCommand(command.text, command.line)
+	if err != nil {
+		return []error{err}
+	}
+
+	if env == nil {
+		return nil
+	}
+
+	err = placeObstacle(env, environment.Obstacle{}.NewObstacle(kind), position)
+	if err != nil {
+		return []error{ErrSimulation(command.line, 1, err)}
+	}
+	return nil
+}
+
+// validateRetirement checks a retire command, and retires the rover from the
+// scratch environment. If env is nil, the command is only checked for syntax
+// errors.
+func (m *Mission) validateRetirement(env environmentiface.Environmenter, command numberedCommand) []error {
+	id, err := parseRetireCommand(command.text, command.line)
+	if err != nil {
+		return []error{err}
+	}
+
+	if env == nil {
+		return nil
+	}
+
+	err = retire(env, id)
+	if err != nil {
+		return []error{ErrSimulation(command.line, 1, err)}
+	}
+	return nil
+}
+
+// validateRover checks a rover's position and navigation commands, and
+// simulates the rover (with the specified ID) within the scratch environment.
+// If env is nil, the commands are only checked for syntax errors.
+func (m *Mission) validateRover(env environmentiface.Environmenter, id string, position, instructions numberedCommand) []error {
+	problems := []error{}
+
+	var rover roveriface.RoverAPI
+	point, heading, err := parsePositionCommand(position.text, position.line)
+	if err != nil {
+		problems = append(problems, err)
+	} else if env != nil {
+		rover, err = m.launchScratchRover(id, heading, point, env)
+		if err != nil {
+			problems = append(problems, ErrSimulation(position.line, 1, err))
+			rover = nil
+		}
+	}
+
+	return append(problems, m.validateInstructions(rover, instructions.text, instructions.line)...)
+}
+
+// launchScratchRover launches an objects.Rover in place of a rover that the
+// mission's RoverBuilder would build. The scratch rover is only given the
+// specified ID if the mission would give the ID to the rover that it replaces
+// (see launchRover).
+func (m *Mission) launchScratchRover(id string, heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter) (roveriface.RoverAPI, error) {
+	if _, ok := m.roverBuilder.(roveriface.RoverRestorer); ok && id != "" {
+		return objects.Rover{}.RestoreRover(id, heading, position, env, m.validationRoverOptions...)
+	}
+	return objects.Rover{}.LaunchRover(heading, position, env, m.validationRoverOptions...)
+}
+
+// validateInstructions checks a rover's navigation instructions, and returns
+// any problems that it finds. If rover is nil, the instructions are only
+// checked for syntax errors.
+//
+// If the mission has a StepBudget, a rover supplied more instructions than the
+// budget allows is reported as a *SimulationError (wrapping a
+// *BudgetExhaustedError) at the first instruction beyond the budget, and the
+// rover's remaining instructions are only checked for syntax errors.
+func (m *Mission) validateInstructions(rover roveriface.RoverAPI, command string, line int) []error {
+	problems := []error{}
+
+	var report *RoverReport
+	if rover != nil {
+		var err error
+		report, err = m.startReport(rover)
+		if err != nil {
+			return append(problems, ErrSimulation(line, 1, err))
+		}
+	}
+
+	for _, token := range splitInstructions(command) {
+		column := token.column
+		instruction, found := m.instructions[token.instruction]
+		if !found {
+			problems = append(problems, ErrParsingInstructionCommand(cleanCommand(command), line, column))
+			continue
+		}
+
+		if rover == nil {
+			continue
+		}
+
+		if m.stepBudget > 0 && report.CommandsConsumed >= m.stepBudget {
+			err := recordFinalState(rover, report)
+			if err == nil {
+				err = ErrBudgetExhausted(m.stepBudget, *report)
+			}
+			problems = append(problems, ErrSimulation(line, column, err))
+			rover = nil
+			continue
+		}
+
+		report.CommandsConsumed++
+		err := instruction(rover)
+		if err == nil {
+			position, err := rover.CurrentPosition()
+			if err == nil && *position != report.FinalPosition {
+				report.MovesMade++
+				report.FinalPosition = *position
+			}
+			continue
+		}
+
+		problems = append(problems, ErrSimulation(line, column, err))
+		policy, blocked := m.blockedMovePolicy(err)
+		if !blocked || policy == PolicyStop {
+			rover = nil
+			continue
+		}
+		report.MovesBlocked++
+	}
+	return problems
+}
@@ -1,17 +1,78 @@
 package objects
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
 	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
 // A Rover is a vehicle that traverses an environment.
+//
+// A rover keeps a record of its activity (see Telemetry).
 type Rover struct {
-	id      string
-	env     environmentiface.Environmenter
-	heading spatial.Heading
+	id        string
+	env       environmentiface.Environmenter
+	heading   spatial.Heading
+	maxSlope  *int
+	battery   *battery
+	telemetry rovertypes.Telemetry
+
+	// lastPosition is the last position that the rover is known to have
+	// occupied.
+	lastPosition spatial.Point
+}
+
+// battery is a rover's energy store.
+type battery struct {
+	config    BatteryConfig
+	remaining int
+}
+
+// A RoverOption configures optional rover behavior.
+type RoverOption func(*Rover)
+
+// MaxSlope limits the change in elevation that a rover can climb (or descend)
+// in a single move. If the environment is an ElevationMapper, and a move would
+// change the rover's elevation by more than limit, the move is rejected with a
+// *SlopeTooSteepError. By default, a rover's moves are not limited by slope.
+func MaxSlope(limit int) RoverOption {
+	return func(r *Rover) {
+		r.maxSlope = &limit
+	}
+}
+
+// BatteryConfig describes a rover's battery. Each value must not be negative.
+type BatteryConfig struct {
+	// Capacity is the most energy that the battery can hold. The battery is
+	// fully charged when the rover is launched.
+	Capacity int
+
+	// MoveCost and TurnCost are the energy consumed by each move and each
+	// turn.
+	MoveCost int
+	TurnCost int
+
+	// ChargeRate is the energy restored to the battery each time the rover
+	// charges (see Charge).
+	ChargeRate int
+}
+
+// Battery gives a rover an energy store, from which each move and turn draws
+// energy. A move or turn that would draw more energy than remains in the
+// battery is rejected with an *InsufficientEnergyError. By default, a rover
+// has no battery, and its moves and turns are unlimited.
+func Battery(config BatteryConfig) RoverOption {
+	return func(r *Rover) {
+		r.battery = &battery{
+			config:    config,
+			remaining: config.Capacity,
+		}
+	}
 }
 
 // LaunchRover initializes a new rover, and attempts to place it within the
@@ -25,20 +86,56 @@ type Rover struct {
 // A rover cannot be launched in a position that is occupied by another object
 // within the environment. In this caes, the rover will not be initialized, and
 // an error will be returned.
-func (Rover) LaunchRover(heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter) (*Rover, error) {
-	occupied, _, err := env.InspectPosition(position)
+//
+// If the environment is a VacancyChecker (such as an AtomicEnvironmenter), the
+// rover checks that its position is vacant and occupies it as a single
+// operation.
+func (Rover) LaunchRover(heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter, options ...RoverOption) (*Rover, error) {
+	return launchRover(uuid.New().String(), heading, position, env, options)
+}
+
+// RestoreRover initializes a rover with a known ID (such as a rover that was
+// previously saved), and attempts to place it within the environment.
+//
+// Other than the rover's ID, RestoreRover behaves exactly like LaunchRover.
+// The environment will reject the rover if another object with the same ID is
+// already present within the environment.
+func (Rover) RestoreRover(id string, heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter, options ...RoverOption) (*Rover, error) {
+	return launchRover(id, heading, position, env, options)
+}
+
+// launchRover initializes a rover with the specified ID, and attempts to place
+// it within the environment. See LaunchRover for details.
+func launchRover(id string, heading spatial.Heading, position spatial.Point, env environmentiface.Environmenter, options []RoverOption) (*Rover, error) {
+	rover := &Rover{
+		id:           id,
+		env:          env,
+		heading:      heading,
+		lastPosition: position,
+	}
+	for _, option := range options {
+		option(rover)
+	}
+
+	if checker, ok := env.(environmentiface.VacancyChecker); ok {
+		placed, occupants, err := checker.PlaceObjectIfVacant(rover, position)
+		if err != nil {
+			return nil, err
+		}
+
+		if !placed {
+			return nil, rover.errIncompatibleObjectDetected(position, occupants)
+		}
+		return rover, nil
+	}
+
+	occupied, occupants, err := env.InspectPosition(position)
 	if err != nil {
 		return nil, err
 	}
 
 	if occupied {
-		return nil, ErrRoverIncompatibleObjectDetected(position)
-	}
-
-	rover := &Rover{
-		id:      uuid.New().String(),
-		env:     env,
-		heading: heading,
+		return nil, rover.errIncompatibleObjectDetected(position, occupants)
 	}
 
 	err = env.PlaceObject(rover, position)
@@ -62,9 +159,26 @@ func (r *Rover) CurrentPosition() (*spatial.Point, error) {
 	if !found {
 		return nil, ErrRoverExpelledFromEnvironment(r)
 	}
+	r.lastPosition = objectPosition.Position
 	return &objectPosition.Position, nil
 }
 
+// CurrentElevation returns the elevation of the rover's current position. If
+// the rover's environment is not an ElevationMapper, the environment is
+// treated as flat, and the elevation is always 0.
+func (r *Rover) CurrentElevation() (int, error) {
+	position, err := r.CurrentPosition()
+	if err != nil {
+		return 0, err
+	}
+
+	mapper, ok := r.env.(environmentiface.ElevationMapper)
+	if !ok {
+		return 0, nil
+	}
+	return mapper.Elevation(*position)
+}
+
 // CurrentHeading returns the rover's current heading.
 func (r *Rover) CurrentHeading() spatial.Heading {
 	return r.heading
@@ -72,20 +186,37 @@ func (r *Rover) CurrentHeading() spatial.Heading {
 
 // ChangeHeading updates the rover's current heading according to a specified
 // direction (left of right).
-func (r *Rover) ChangeHeading(direction spatial.Direction) {
+//
+// If the rover has a battery (see Battery), and too little energy remains to
+// turn, the rover's heading is unchanged, the turn is recorded within the
+// rover's telemetry as blocked by energy, and an *InsufficientEnergyError is
+// returned.
+func (r *Rover) ChangeHeading(direction spatial.Direction) error {
+	r.telemetry.InstructionsExecuted++
+	if r.battery != nil {
+		err := r.battery.draw(r.battery.config.TurnCost)
+		if err != nil {
+			r.recordBlockedMove(err)
+			return err
+		}
+	}
+
 	if direction == spatial.DirectionRight {
+		r.telemetry.RightTurns++
 		if r.heading == spatial.HeadingWest {
 			r.heading = spatial.HeadingNorth
 		} else {
 			r.heading = spatial.Cardinals[int(r.heading)+1]
 		}
 	} else {
+		r.telemetry.LeftTurns++
 		if r.heading == spatial.HeadingNorth {
 			r.heading = spatial.HeadingWest
 		} else {
 			r.heading = spatial.Cardinals[int(r.heading)-1]
 		}
 	}
+	return nil
 }
 
 // Move attempts to move the rover forward one unit in its current heading.
@@ -101,6 +232,12 @@ func (r *Rover) ChangeHeading(direction spatial.Direction) {
 //   2. The next position would result in moving to a space already occupied
 //   by another object in the environment.
 //
+//   3. The rover's slope is limited (see MaxSlope), and the next position
+//   is too far above or below the rover's current position.
+//
+//   4. The rover has a battery (see Battery), and too little energy remains
+//   to move. Energy is only drawn from the battery if the move succeeds.
+//
 // If a move fails, an error will be returned. In the case of a failed move
 // it is recommended to check the CurrentPosition method to verify the position
 // of the rover. If the rover itself decided a move was illegal (for instance,
@@ -109,35 +246,224 @@ func (r *Rover) ChangeHeading(direction spatial.Direction) {
 // prohibed by its environment, then it is possible that the rover's position has
 // changed within the environment (according to the particular environment's
 // rules).
+//
+// If the environment is a VacancyChecker (such as an AtomicEnvironmenter), the
+// rover checks that the next position is vacant and moves into it as a single
+// operation, which allows the environment to report the move if it is
+// rejected.
+//
+// If the environment is a PositionNormalizer (such as a Torus), the next
+// position is normalized before it is checked, so errors refer to positions
+// within the environment. A move that brings the rover back to its own
+// position (such as a move along a torus that is a single position wide)
+// succeeds without changing the rover's position.
 func (r *Rover) Move() error {
+	return r.drive(1)
+}
+
+// Reverse attempts to move the rover backward one unit, opposite to its
+// current heading, without changing its heading. Reverse is subject to the same
+// rules as Move, and fails in the same scenarios.
+func (r *Rover) Reverse() error {
+	return r.drive(-1)
+}
+
+// drive attempts to move the rover one unit along its current heading (if step
+// is 1) or opposite to it (if step is -1), and records the outcome within the
+// rover's telemetry and battery. See Move for details.
+func (r *Rover) drive(step int) error {
+	r.telemetry.InstructionsExecuted++
+	if r.battery != nil && r.battery.remaining < r.battery.config.MoveCost {
+		err := ErrRoverInsufficientEnergy(r.battery.config.MoveCost, r.battery.remaining)
+		r.recordBlockedMove(err)
+		return err
+	}
+
+	err := r.move(step)
+	if err != nil {
+		r.recordBlockedMove(err)
+		return err
+	}
+
+	if r.battery != nil {
+		r.battery.remaining -= r.battery.config.MoveCost
+	}
+	r.telemetry.CellsTraveled++
+	return nil
+}
+
+// move attempts to move the rover one unit along its current heading (if step
+// is 1) or opposite to it (if step is -1). See Move for details.
+func (r *Rover) move(step int) error {
 	found, objectPosition := r.env.FindObject(r)
 	if !found {
 		return ErrRoverExpelledFromEnvironment(r)
 	}
+	r.lastPosition = objectPosition.Position
 
 	newPosition := objectPosition.Position
 	switch r.heading {
 	case spatial.HeadingNorth:
-		newPosition.Y++
+		newPosition.Y += step
 	case spatial.HeadingEast:
-		newPosition.X++
+		newPosition.X += step
 	case spatial.HeadingSouth:
-		newPosition.Y--
+		newPosition.Y -= step
 	case spatial.HeadingWest:
-		newPosition.X--
+		newPosition.X -= step
+	}
+
+	if normalizer, ok := r.env.(environmentiface.PositionNormalizer); ok {
+		newPosition = normalizer.Normalize(newPosition)
+	}
+	if newPosition == objectPosition.Position {
+		// The environment wraps the rover around to its own position (as on
+		// a torus that is a single position wide), so there is nothing to
+		// move.
+		return nil
+	}
+
+	err := r.verifySlope(objectPosition.Position, newPosition)
+	if err != nil {
+		return err
 	}
 
-	occupied, _, err := r.env.InspectPosition(newPosition)
+	if checker, ok := r.env.(environmentiface.VacancyChecker); ok {
+		moved, occupants, err := checker.MoveObjectIfVacant(r, newPosition)
+		if err != nil {
+			return err
+		}
+
+		if !moved {
+			return r.errIncompatibleObjectDetected(newPosition, occupants)
+		}
+		r.lastPosition = newPosition
+		return nil
+	}
+
+	occupied, occupants, err := r.env.InspectPosition(newPosition)
 	if err != nil {
 		return err
 	}
 
 	if occupied {
-		return ErrRoverIncompatibleObjectDetected(newPosition)
+		return r.errIncompatibleObjectDetected(newPosition, occupants)
+	}
+
+	err = r.env.RecordMovement(r, newPosition)
+	if err != nil {
+		return err
+	}
+	r.lastPosition = newPosition
+	return nil
+}
+
+// errIncompatibleObjectDetected returns an *IncompatibleObjectError that
+// identifies the rover as having detected the incompatible objects.
+func (r *Rover) errIncompatibleObjectDetected(position spatial.Point, objects []objectiface.Objecter) error {
+	err := ErrRoverIncompatibleObjectDetected(position, objects...).(*IncompatibleObjectError)
+	err.RoverID = r.id
+	return err
+}
+
+// RemainingEnergy returns the energy remaining within the rover's battery, and
+// true. If the rover has no battery (see Battery), RemainingEnergy returns 0
+// and false.
+func (r *Rover) RemainingEnergy() (int, bool) {
+	if r.battery == nil {
+		return 0, false
+	}
+	return r.battery.remaining, true
+}
+
+// Charge restores energy to the rover's battery, according to the battery's
+// ChargeRate, without exceeding the battery's Capacity. The rover remains in
+// place while it charges. If the rover has no battery, Charge has no effect.
+func (r *Rover) Charge() {
+	r.telemetry.InstructionsExecuted++
+	if r.battery == nil {
+		return
+	}
+
+	r.battery.remaining += r.battery.config.ChargeRate
+	if r.battery.remaining > r.battery.config.Capacity {
+		r.battery.remaining = r.battery.config.Capacity
+	}
+}
+
+// draw removes energy from the battery, or returns an error if too little
+// energy remains.
+func (b *battery) draw(energy int) error {
+	if b.remaining < energy {
+		return ErrRoverInsufficientEnergy(energy, b.remaining)
+	}
+	b.remaining -= energy
+	return nil
+}
+
+// Telemetry returns a record of the rover's activity since it was launched.
+func (r *Rover) Telemetry() rovertypes.Telemetry {
+	telemetry := r.telemetry
+	telemetry.BlockedMoves = make(map[rovertypes.BlockReason]int, len(r.telemetry.BlockedMoves))
+	for reason, count := range r.telemetry.BlockedMoves {
+		telemetry.BlockedMoves[reason] = count
+	}
+	return telemetry
+}
+
+// recordBlockedMove records a blocked move within the rover's telemetry,
+// according to the reason implied by the error that blocked the move.
+func (r *Rover) recordBlockedMove(err error) {
+	reason := rovertypes.BlockedByEnvironment
+	var incompatibleObject *IncompatibleObjectError
+	var slopeTooSteep *SlopeTooSteepError
+	var insufficientEnergy *InsufficientEnergyError
+	if errors.As(err, &incompatibleObject) {
+		reason = rovertypes.BlockedByObject
+	} else if errors.As(err, &slopeTooSteep) {
+		reason = rovertypes.BlockedBySlope
+	} else if errors.As(err, &insufficientEnergy) {
+		reason = rovertypes.BlockedByEnergy
 	}
 
-	return r.env.RecordMovement(r, newPosition)
+	if r.telemetry.BlockedMoves == nil {
+		r.telemetry.BlockedMoves = make(map[rovertypes.BlockReason]int)
+	}
+	r.telemetry.BlockedMoves[reason]++
+}
+
+// verifySlope returns an error if the rover's slope is limited, and the
+// change in elevation between two positions exceeds the limit.
+func (r *Rover) verifySlope(from, to spatial.Point) error {
+	mapper, ok := r.env.(environmentiface.ElevationMapper)
+	if r.maxSlope == nil || !ok {
+		return nil
+	}
+
+	fromElevation, err := mapper.Elevation(from)
+	if err != nil {
+		return err
+	}
+
+	toElevation, err := mapper.Elevation(to)
+	if err != nil {
+		// The position does not exist within the environment, so the
+		// environment itself rejects the move.
+		return nil
+	}
+
+	change := toElevation - fromElevation
+	if change > *r.maxSlope || -change > *r.maxSlope {
+		return ErrRoverSlopeTooSteep(to, change, *r.maxSlope)
+	}
+	return nil
 }
 
-// Assert Rover implements RoverAPI
-var _ roveriface.RoverAPI = (*Rover)(nil)
+// Assert Rover implements RoverAPI, ElevationReporter, TelemetryReporter and
+// BatteryPowered
+var (
+	_ roveriface.RoverAPI          = (*Rover)(nil)
+	_ roveriface.ElevationReporter = (*Rover)(nil)
+	_ roveriface.TelemetryReporter = (*Rover)(nil)
+	_ roveriface.BatteryPowered    = (*Rover)(nil)
+)
@@ -1,14 +1,20 @@
 package objects_test
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	mock_environmentiface "github.com/jecolasurdo/marsrover/mocks/environment"
+	mock_objectiface "github.com/jecolasurdo/marsrover/mocks/objects"
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
 	"github.com/jecolasurdo/marsrover/pkg/environment/environmenttypes"
 	"github.com/jecolasurdo/marsrover/pkg/objects"
 	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 	"github.com/stretchr/testify/assert"
 )
@@ -205,31 +211,60 @@ func Test_RoverChangeHeading(t *testing.T) {
 }
 
 func Test_RoverMove(t *testing.T) {
-	t.Run("move in each direction succeeds in proper calls to environment", func(t *testing.T) {
+	t.Run("move or reverse in each direction succeeds in proper calls to environment", func(t *testing.T) {
 		testCases := []struct {
 			initialHeading    spatial.Heading
 			initialPosition   spatial.Point
 			resultingPosition spatial.Point
+			reverse           bool
 		}{
 			{
 				spatial.HeadingNorth,
 				spatial.NewPoint(3, 7),
 				spatial.NewPoint(3, 8),
+				false,
 			},
 			{
 				spatial.HeadingEast,
 				spatial.NewPoint(3, 7),
 				spatial.NewPoint(4, 7),
+				false,
 			},
 			{
 				spatial.HeadingSouth,
 				spatial.NewPoint(3, 7),
 				spatial.NewPoint(3, 6),
+				false,
 			},
 			{
 				spatial.HeadingWest,
 				spatial.NewPoint(3, 7),
 				spatial.NewPoint(2, 7),
+				false,
+			},
+			{
+				spatial.HeadingNorth,
+				spatial.NewPoint(3, 7),
+				spatial.NewPoint(3, 6),
+				true,
+			},
+			{
+				spatial.HeadingEast,
+				spatial.NewPoint(3, 7),
+				spatial.NewPoint(2, 7),
+				true,
+			},
+			{
+				spatial.HeadingSouth,
+				spatial.NewPoint(3, 7),
+				spatial.NewPoint(3, 8),
+				true,
+			},
+			{
+				spatial.HeadingWest,
+				spatial.NewPoint(3, 7),
+				spatial.NewPoint(4, 7),
+				true,
 			},
 		}
 
@@ -266,8 +301,13 @@ func Test_RoverMove(t *testing.T) {
 					Return(nil).
 					Times(1)
 
-				err = rover.Move()
+				if testCase.reverse {
+					err = rover.Reverse()
+				} else {
+					err = rover.Move()
+				}
 				assert.Nil(t, err)
+				assert.Equal(t, testCase.initialHeading, rover.CurrentHeading())
 			})
 		}
 	})
@@ -390,4 +430,505 @@ func Test_RoverMove(t *testing.T) {
 			err = rover.Move()
 			assert.EqualError(t, err, objects.ErrRoverIncompatibleObjectDetected(attemptedPosition).Error())
 		})
+
+	t.Run("reverse is subject to collisions and bounds", func(t *testing.T) {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+		assert.NoError(t, err)
+		obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		assert.NoError(t, plateau.PlaceObject(obstacle, spatial.NewPoint(2, 1)))
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingSouth, spatial.NewPoint(2, 0), plateau)
+		assert.NoError(t, err)
+
+		err = rover.Reverse()
+		assert.EqualError(t, err, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(2, 1), obstacle).Error())
+
+		rover.ChangeHeading(spatial.DirectionLeft)
+		assert.NoError(t, rover.Reverse())
+		assert.NoError(t, rover.Reverse())
+		err = rover.Reverse()
+		assert.EqualError(t, err, environment.ErrPositionOutsideBounds(spatial.NewPoint(-1, 0)).Error())
+
+		position, err := rover.CurrentPosition()
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.NewPoint(0, 0), *position)
+		assert.Equal(t, spatial.HeadingEast, rover.CurrentHeading())
+	})
+}
+
+func Test_RoverErrors(t *testing.T) {
+	t.Run("collisions report the position and the IDs of the occupants", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		occupant := mock_objectiface.NewMockObjecter(ctrl)
+		occupant.EXPECT().ID().Return("occupant").AnyTimes()
+
+		position := spatial.NewPoint(4, 5)
+		env := mock_environmentiface.NewMockEnvironmenter(ctrl)
+		env.EXPECT().
+			InspectPosition(position).
+			Return(true, []objectiface.Objecter{occupant}, nil)
+
+		_, err := objects.Rover{}.RestoreRover("rover", spatial.HeadingNorth, position, env)
+
+		var incompatibleObject *objects.IncompatibleObjectError
+		assert.True(t, errors.As(err, &incompatibleObject))
+		assert.Equal(t, "rover", incompatibleObject.RoverID)
+		assert.Equal(t, position, incompatibleObject.Position)
+		assert.Equal(t, []string{"occupant"}, incompatibleObject.ObjectIDs)
+	})
+
+	t.Run("expulsion reports the rover's ID and last known position", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		env := mock_environmentiface.NewMockEnvironmenter(ctrl)
+		env.EXPECT().InspectPosition(gomock.Any()).Return(false, nil, nil)
+		env.EXPECT().PlaceObject(gomock.Any(), gomock.Any()).Return(nil)
+		env.EXPECT().FindObject(gomock.Any()).Return(false, nil)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(1, 1), env)
+		assert.NoError(t, err)
+
+		err = rover.Move()
+		var expelled *objects.RoverExpelledError
+		assert.True(t, errors.As(err, &expelled))
+		assert.Equal(t, rover.ID(), expelled.RoverID)
+		assert.Equal(t, spatial.NewPoint(1, 1), expelled.Position)
+	})
+}
+
+func Test_RoverAtomicEnvironment(t *testing.T) {
+	t.Run("launching checks and occupies the position atomically", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		occupant := mock_objectiface.NewMockObjecter(ctrl)
+		occupant.EXPECT().ID().Return("occupant").AnyTimes()
+
+		env := mock_environmentiface.NewMockAtomicEnvironmenter(ctrl)
+		env.EXPECT().
+			PlaceObjectIfVacant(gomock.Any(), spatial.NewPoint(1, 1)).
+			Return(true, nil, nil)
+		env.EXPECT().
+			PlaceObjectIfVacant(gomock.Any(), spatial.NewPoint(2, 2)).
+			Return(false, []objectiface.Objecter{occupant}, nil)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(1, 1), env)
+		assert.NoError(t, err)
+		assert.NotNil(t, rover)
+
+		rover, err = objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(2, 2), env)
+		assert.Nil(t, rover)
+		assert.EqualError(t, err, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(2, 2), occupant).Error())
+	})
+
+	t.Run("moving checks and occupies the position atomically", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		occupant := mock_objectiface.NewMockObjecter(ctrl)
+		occupant.EXPECT().ID().Return("occupant").AnyTimes()
+
+		env := mock_environmentiface.NewMockAtomicEnvironmenter(ctrl)
+		env.EXPECT().
+			PlaceObjectIfVacant(gomock.Any(), gomock.Any()).
+			Return(true, nil, nil)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(1, 1), env)
+		assert.NoError(t, err)
+
+		env.EXPECT().
+			FindObject(rover).
+			Return(true, &environmenttypes.ObjectPosition{Object: rover, Position: spatial.NewPoint(1, 1)}).
+			Times(2)
+		gomock.InOrder(
+			env.EXPECT().
+				MoveObjectIfVacant(rover, spatial.NewPoint(1, 2)).
+				Return(true, nil, nil),
+			env.EXPECT().
+				MoveObjectIfVacant(rover, spatial.NewPoint(1, 2)).
+				Return(false, []objectiface.Objecter{occupant}, nil),
+		)
+
+		assert.NoError(t, rover.Move())
+		assert.EqualError(t, rover.Move(), objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 2), occupant).Error())
+	})
+
+	t.Run("concurrent rovers never share a position", func(t *testing.T) {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(10, 10))
+		assert.NoError(t, err)
+		env := environment.SyncEnvironment{}.NewSyncEnvironment(plateau)
+
+		// Each rover starts on the bottom row, and is then driven to the
+		// same cell from a separate goroutine.
+		const roverCount = 10
+		rovers := []*objects.Rover{}
+		for i := 0; i <= roverCount; i++ {
+			rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(i, 0), env)
+			assert.NoError(t, err)
+			rovers = append(rovers, rover)
+		}
+
+		wg := sync.WaitGroup{}
+		for i, rover := range rovers {
+			wg.Add(1)
+			go func(i int, rover *objects.Rover) {
+				defer wg.Done()
+				for step := 0; step < 5; step++ {
+					_ = rover.Move()
+				}
+				if i < 5 {
+					rover.ChangeHeading(spatial.DirectionRight)
+				} else {
+					rover.ChangeHeading(spatial.DirectionLeft)
+				}
+				for step := 0; step < 5; step++ {
+					_ = rover.Move()
+				}
+			}(i, rover)
+		}
+		wg.Wait()
+
+		for position, objects := range env.ShowObjects() {
+			assert.Len(t, objects, 1, "position %v", position)
+		}
+	})
+}
+
+func Test_RoverRejectedMoveEvents(t *testing.T) {
+	testCases := []struct {
+		name string
+		wrap func(*environment.Plateau) environmentiface.Environmenter
+	}{
+		{"plateau", func(p *environment.Plateau) environmentiface.Environmenter { return p }},
+		{"sync environment", func(p *environment.Plateau) environmentiface.Environmenter {
+			return environment.SyncEnvironment{}.NewSyncEnvironment(p)
+		}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(2, 2))
+			assert.NoError(t, err)
+			obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+			assert.NoError(t, plateau.PlaceObject(obstacle, spatial.NewPoint(1, 1)))
+
+			env := testCase.wrap(plateau)
+			rover, err := objects.Rover{}.LaunchRover(spatial.HeadingEast, spatial.NewPoint(0, 1), env)
+			assert.NoError(t, err)
+
+			rejected := []environmenttypes.Event{}
+			env.(environmentiface.Observable).Subscribe(func(event environmenttypes.Event) {
+				if event.Kind == environmenttypes.EventMoveRejected {
+					rejected = append(rejected, event)
+				}
+			})
+
+			// The first move is blocked by the obstacle, and the second by
+			// the western edge of the plateau.
+			assert.Error(t, rover.Move())
+			assert.Error(t, rover.Reverse())
+
+			if assert.Len(t, rejected, 2) {
+				assert.Equal(t, rover.ID(), rejected[0].Object.ID())
+				assert.Equal(t, spatial.NewPoint(0, 1), rejected[0].From)
+				assert.Equal(t, spatial.NewPoint(1, 1), rejected[0].To)
+				var occupied *environment.PositionOccupiedError
+				if assert.True(t, errors.As(rejected[0].Err, &occupied)) {
+					assert.Equal(t, rover.ID(), occupied.ObjectID)
+					assert.Equal(t, []string{obstacle.ID()}, occupied.OccupantIDs)
+				}
+
+				assert.Equal(t, rover.ID(), rejected[1].Object.ID())
+				assert.Equal(t, spatial.NewPoint(0, 1), rejected[1].From)
+				assert.Equal(t, spatial.NewPoint(-1, 1), rejected[1].To)
+				var outsideBounds *environment.PositionOutsideBoundsError
+				if assert.True(t, errors.As(rejected[1].Err, &outsideBounds)) {
+					assert.Equal(t, rover.ID(), outsideBounds.ObjectID)
+				}
+			}
+		})
+	}
+}
+
+func Test_RestoreRover(t *testing.T) {
+	plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+	assert.NoError(t, err)
+
+	rover, err := objects.Rover{}.RestoreRover("saved", spatial.HeadingWest, spatial.NewPoint(2, 3), plateau)
+	assert.NoError(t, err)
+	assert.Equal(t, "saved", rover.ID())
+	assert.Equal(t, spatial.HeadingWest, rover.CurrentHeading())
+
+	duplicate, err := objects.Rover{}.RestoreRover("saved", spatial.HeadingWest, spatial.NewPoint(4, 4), plateau)
+	assert.Nil(t, duplicate)
+	assert.EqualError(t, err, environment.ErrObjectAlreadyExists(rover).Error())
+}
+
+func Test_RoverOnTorus(t *testing.T) {
+	t.Run("blocked moves refer to normalized positions", func(t *testing.T) {
+		torus, err := environment.Torus{}.NewTorus(spatial.NewPoint(4, 1))
+		assert.NoError(t, err)
+		obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		assert.NoError(t, torus.PlaceObject(obstacle, spatial.NewPoint(1, 0)))
+
+		for _, env := range []environmentiface.Environmenter{torus, environment.SyncEnvironment{}.NewSyncEnvironment(torus)} {
+			rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(1, 1), env)
+			assert.NoError(t, err)
+
+			err = rover.Move()
+			assert.EqualError(t, err, objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(1, 0), obstacle).Error())
+			var incompatibleObject *objects.IncompatibleObjectError
+			if assert.True(t, errors.As(err, &incompatibleObject)) {
+				assert.Equal(t, spatial.NewPoint(1, 0), incompatibleObject.Position)
+			}
+			assert.NoError(t, torus.RemoveObject(rover))
+		}
+	})
+
+	t.Run("moving around a single-position torus leaves the rover in place", func(t *testing.T) {
+		torus, err := environment.Torus{}.NewTorus(spatial.NewPoint(3, 0))
+		assert.NoError(t, err)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(2, 0), torus)
+		assert.NoError(t, err)
+		assert.NoError(t, rover.Move())
+		assert.NoError(t, rover.Reverse())
+
+		position, err := rover.CurrentPosition()
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.NewPoint(2, 0), *position)
+		assert.Empty(t, rover.Telemetry().BlockedMoves)
+
+		rover.ChangeHeading(spatial.DirectionRight)
+		assert.NoError(t, rover.Move())
+		position, err = rover.CurrentPosition()
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.NewPoint(3, 0), *position)
+	})
+}
+
+func Test_RoverExpelled(t *testing.T) {
+	plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+	assert.NoError(t, err)
+
+	rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(2, 2), plateau)
+	assert.NoError(t, err)
+	assert.NoError(t, plateau.RemoveObject(rover))
+
+	position, err := rover.CurrentPosition()
+	assert.Nil(t, position)
+	assert.EqualError(t, err, objects.ErrRoverExpelledFromEnvironment(rover).Error())
+	assert.EqualError(t, rover.Move(), objects.ErrRoverExpelledFromEnvironment(rover).Error())
+
+	// The rover no longer blocks its former position.
+	_, err = objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(2, 2), plateau)
+	assert.NoError(t, err)
+}
+
+func Test_RoverMaxSlope(t *testing.T) {
+	// Elevations rise steeply towards the east, and gently towards the north.
+	heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{
+		{2, 5, 9},
+		{1, 4, 8},
+		{0, 3, 7},
+	})
+	assert.NoError(t, err)
+
+	plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(2, 2))
+	assert.NoError(t, err)
+	assert.NoError(t, plateau.SetHeightMap(heightMap))
+
+	t.Run("moves within the limit succeed", func(t *testing.T) {
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingEast, spatial.NewPoint(0, 0), plateau, objects.MaxSlope(3))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, plateau.RemoveObject(rover)) }()
+
+		assert.NoError(t, rover.Move())
+		elevation, err := rover.CurrentElevation()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, elevation)
+	})
+
+	t.Run("moves beyond the limit are rejected", func(t *testing.T) {
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingEast, spatial.NewPoint(1, 1), plateau, objects.MaxSlope(3))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, plateau.RemoveObject(rover)) }()
+
+		err = rover.Move()
+		assert.EqualError(t, err, objects.ErrRoverSlopeTooSteep(spatial.NewPoint(2, 1), 4, 3).Error())
+		var slopeTooSteep *objects.SlopeTooSteepError
+		assert.True(t, errors.As(err, &slopeTooSteep))
+
+		position, err := rover.CurrentPosition()
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.NewPoint(1, 1), *position)
+
+		// Descending is limited in the same way as climbing.
+		descending, err := objects.Rover{}.LaunchRover(spatial.HeadingWest, spatial.NewPoint(2, 0), plateau, objects.MaxSlope(3))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, plateau.RemoveObject(descending)) }()
+		err = descending.Move()
+		assert.EqualError(t, err, objects.ErrRoverSlopeTooSteep(spatial.NewPoint(1, 0), -4, 3).Error())
+	})
+
+	t.Run("rovers without a limit can climb any slope", func(t *testing.T) {
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingEast, spatial.NewPoint(1, 2), plateau)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, plateau.RemoveObject(rover)) }()
+
+		assert.NoError(t, rover.Move())
+		elevation, err := rover.CurrentElevation()
+		assert.NoError(t, err)
+		assert.Equal(t, 9, elevation)
+	})
+}
+
+func Test_RoverTelemetry(t *testing.T) {
+	t.Run("a new rover has no activity", func(t *testing.T) {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(2, 2))
+		assert.NoError(t, err)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(0, 0), plateau)
+		assert.NoError(t, err)
+		assert.Equal(t, rovertypes.Telemetry{BlockedMoves: map[rovertypes.BlockReason]int{}}, rover.Telemetry())
+	})
+
+	t.Run("records moves, turns, and blocked moves by reason", func(t *testing.T) {
+		heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{
+			{0, 0, 0},
+			{0, 0, 9},
+			{0, 0, 0},
+		})
+		assert.NoError(t, err)
+
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(2, 2))
+		assert.NoError(t, err)
+		assert.NoError(t, plateau.SetHeightMap(heightMap))
+		obstacle := environment.Obstacle{}.NewObstacle(environment.ObstacleRock)
+		assert.NoError(t, plateau.PlaceObject(obstacle, spatial.NewPoint(0, 2)))
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(0, 0), plateau, objects.MaxSlope(1))
+		assert.NoError(t, err)
+
+		assert.NoError(t, rover.Move())
+		assert.Error(t, rover.Move()) // the obstacle
+		rover.ChangeHeading(spatial.DirectionRight)
+		assert.NoError(t, rover.Move())
+		assert.Error(t, rover.Move()) // the slope
+		rover.ChangeHeading(spatial.DirectionLeft)
+		rover.ChangeHeading(spatial.DirectionLeft)
+		rover.ChangeHeading(spatial.DirectionLeft)
+		assert.NoError(t, rover.Move())
+		assert.Error(t, rover.Move()) // the bounds of the plateau
+
+		assert.Equal(t, rovertypes.Telemetry{
+			InstructionsExecuted: 10,
+			CellsTraveled:        3,
+			LeftTurns:            3,
+			RightTurns:           1,
+			BlockedMoves: map[rovertypes.BlockReason]int{
+				rovertypes.BlockedByObject:      1,
+				rovertypes.BlockedBySlope:       1,
+				rovertypes.BlockedByEnvironment: 1,
+			},
+		}, rover.Telemetry())
+		assert.Equal(t, 3, rover.Telemetry().TotalBlockedMoves())
+	})
+
+	t.Run("the returned telemetry is a copy", func(t *testing.T) {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(2, 2))
+		assert.NoError(t, err)
+
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingSouth, spatial.NewPoint(0, 0), plateau)
+		assert.NoError(t, err)
+		assert.Error(t, rover.Move())
+
+		telemetry := rover.Telemetry()
+		telemetry.BlockedMoves[rovertypes.BlockedByEnvironment] = 10
+		assert.Equal(t, 1, rover.Telemetry().BlockedMoves[rovertypes.BlockedByEnvironment])
+	})
+}
+
+func Test_RoverBattery(t *testing.T) {
+	launch := func(t *testing.T, config objects.BatteryConfig) *objects.Rover {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+		assert.NoError(t, err)
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(0, 0), plateau, objects.Battery(config))
+		assert.NoError(t, err)
+		return rover
+	}
+
+	t.Run("rovers without a battery have unlimited energy", func(t *testing.T) {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+		assert.NoError(t, err)
+		rover, err := objects.Rover{}.LaunchRover(spatial.HeadingNorth, spatial.NewPoint(0, 0), plateau)
+		assert.NoError(t, err)
+
+		energy, powered := rover.RemainingEnergy()
+		assert.False(t, powered)
+		assert.Equal(t, 0, energy)
+		assert.NoError(t, rover.Move())
+		assert.NoError(t, rover.ChangeHeading(spatial.DirectionRight))
+	})
+
+	t.Run("moves and turns draw energy", func(t *testing.T) {
+		rover := launch(t, objects.BatteryConfig{Capacity: 10, MoveCost: 3, TurnCost: 1})
+		assert.NoError(t, rover.Move())
+		assert.NoError(t, rover.ChangeHeading(spatial.DirectionRight))
+
+		energy, powered := rover.RemainingEnergy()
+		assert.True(t, powered)
+		assert.Equal(t, 6, energy)
+	})
+
+	t.Run("a depleted battery rejects moves", func(t *testing.T) {
+		rover := launch(t, objects.BatteryConfig{Capacity: 5, MoveCost: 3})
+		assert.NoError(t, rover.Move())
+
+		err := rover.Move()
+		assert.EqualError(t, err, objects.ErrRoverInsufficientEnergy(3, 2).Error())
+		var insufficientEnergy *objects.InsufficientEnergyError
+		assert.True(t, errors.As(err, &insufficientEnergy))
+
+		position, err := rover.CurrentPosition()
+		assert.NoError(t, err)
+		assert.Equal(t, spatial.NewPoint(0, 1), *position)
+		assert.Equal(t, 1, rover.Telemetry().BlockedMoves[rovertypes.BlockedByEnergy])
+	})
+
+	t.Run("a depleted battery rejects turns", func(t *testing.T) {
+		rover := launch(t, objects.BatteryConfig{Capacity: 1, TurnCost: 1})
+		assert.NoError(t, rover.ChangeHeading(spatial.DirectionLeft))
+
+		err := rover.ChangeHeading(spatial.DirectionLeft)
+		assert.EqualError(t, err, objects.ErrRoverInsufficientEnergy(1, 0).Error())
+		assert.Equal(t, spatial.HeadingWest, rover.CurrentHeading())
+		assert.Equal(t, 1, rover.Telemetry().BlockedMoves[rovertypes.BlockedByEnergy])
+	})
+
+	t.Run("blocked moves do not draw energy", func(t *testing.T) {
+		rover := launch(t, objects.BatteryConfig{Capacity: 5, MoveCost: 1})
+		rover.ChangeHeading(spatial.DirectionLeft)
+		assert.Error(t, rover.Move())
+
+		energy, _ := rover.RemainingEnergy()
+		assert.Equal(t, 5, energy)
+	})
+
+	t.Run("charging restores energy up to the capacity", func(t *testing.T) {
+		rover := launch(t, objects.BatteryConfig{Capacity: 5, MoveCost: 2, ChargeRate: 3})
+		assert.NoError(t, rover.Move())
+		assert.NoError(t, rover.Move())
+
+		rover.Charge()
+		energy, _ := rover.RemainingEnergy()
+		assert.Equal(t, 4, energy)
+
+		rover.Charge()
+		energy, _ = rover.RemainingEnergy()
+		assert.Equal(t, 5, energy)
+	})
 }
@@ -3,17 +3,99 @@ package objects
 import (
 	"fmt"
 
+	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
+// RoverExpelledError occurs if the rover's underlaying environment no longer
+// recognizes the rover as existing.
+type RoverExpelledError struct {
+	RoverID string
+
+	// Position is the last position that the rover is known to have
+	// occupied.
+	Position spatial.Point
+}
+
 // ErrRoverExpelledFromEnvironment occurs if the rover's underlaying environment
 // no longer recognizes the rover as existing.
 func ErrRoverExpelledFromEnvironment(rover *Rover) error {
-	return fmt.Errorf("rover '%v' is no longer recognised by its environment", rover.ID())
+	return &RoverExpelledError{RoverID: rover.ID(), Position: rover.lastPosition}
+}
+
+func (e *RoverExpelledError) Error() string {
+	return fmt.Sprintf("rover '%v' is no longer recognised by its environment", e.RoverID)
+}
+
+// IncompatibleObjectError is returned if a rover detects an incompatible
+// object at some position within its environment (for instance, if the rover
+// would collide with another rover).
+type IncompatibleObjectError struct {
+	// RoverID is the ID of the rover that detected the incompatible objects,
+	// if it is known.
+	RoverID string
+
+	// Position is the position at which the incompatible objects were
+	// detected.
+	Position spatial.Point
+
+	// ObjectIDs are the IDs of the incompatible objects, if they are known.
+	ObjectIDs []string
 }
 
 // ErrRoverIncompatibleObjectDetected is returned if a rover detects an
 // incompatible object at some position within its environment.
-func ErrRoverIncompatibleObjectDetected(position spatial.Point) error {
-	return fmt.Errorf("an incompatible object was dectected at position '%v'", position)
+func ErrRoverIncompatibleObjectDetected(position spatial.Point, objects ...objectiface.Objecter) error {
+	objectIDs := []string{}
+	for _, object := range objects {
+		objectIDs = append(objectIDs, object.ID())
+	}
+	return &IncompatibleObjectError{Position: position, ObjectIDs: objectIDs}
+}
+
+func (e *IncompatibleObjectError) Error() string {
+	return fmt.Sprintf("an incompatible object was dectected at position '%v'", e.Position)
+}
+
+// SlopeTooSteepError is returned if a rover's move would change its elevation
+// by more than the rover is able to climb or descend.
+type SlopeTooSteepError struct {
+	// Position is the position that the rover attempted to move to.
+	Position spatial.Point
+
+	// Change is the change in elevation that the move would have caused.
+	Change int
+
+	// Limit is the largest change in elevation that the rover can manage.
+	Limit int
+}
+
+// ErrRoverSlopeTooSteep is returned if a rover's move would change its
+// elevation by more than the rover is able to climb or descend.
+func ErrRoverSlopeTooSteep(position spatial.Point, change, limit int) error {
+	return &SlopeTooSteepError{Position: position, Change: change, Limit: limit}
+}
+
+func (e *SlopeTooSteepError) Error() string {
+	return fmt.Sprintf("the slope to position '%v' is too steep (elevation change of %v exceeds the limit of %v)", e.Position, e.Change, e.Limit)
+}
+
+// InsufficientEnergyError is returned if a rover's battery holds too little
+// energy for the rover to move or turn.
+type InsufficientEnergyError struct {
+	// Required is the energy that the move or turn would have consumed.
+	Required int
+
+	// Remaining is the energy that remains within the rover's battery.
+	Remaining int
+}
+
+// ErrRoverInsufficientEnergy is returned if a rover's battery holds too little
+// energy for the rover to move or turn.
+func ErrRoverInsufficientEnergy(required, remaining int) error {
+	return &InsufficientEnergyError{Required: required, Remaining: remaining}
+}
+
+func (e *InsufficientEnergyError) Error() string {
+	return fmt.Sprintf("insufficient energy (%v required, but only %v remaining)", e.Required, e.Remaining)
 }
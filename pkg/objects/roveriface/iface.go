@@ -4,6 +4,7 @@ package roveriface
 import (
 	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
 	"github.com/jecolasurdo/marsrover/pkg/objects/objectiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects/rovertypes"
 	"github.com/jecolasurdo/marsrover/pkg/spatial"
 )
 
@@ -14,6 +15,16 @@ type RoverBuilder interface {
 	LaunchRover(spatial.Heading, spatial.Point, environmentiface.Environmenter) (RoverAPI, error)
 }
 
+// RoverRestorer is a RoverBuilder that can also construct a rover with a known
+// ID.
+type RoverRestorer interface {
+	RoverBuilder
+
+	// RestoreRover initializes a new rover with the supplied ID, and attempts
+	// to place it within the environment.
+	RestoreRover(string, spatial.Heading, spatial.Point, environmentiface.Environmenter) (RoverAPI, error)
+}
+
 // RoverAPI represents anything that can behave like a rover.
 type RoverAPI interface {
 	objectiface.Objecter
@@ -26,10 +37,41 @@ type RoverAPI interface {
 	// CurrentHeading must report the rover's current heading.
 	CurrentHeading() spatial.Heading
 
-	// ChangeHeading must update the rover's current heading.
-	ChangeHeading(spatial.Direction)
+	// ChangeHeading must update the rover's current heading, or return an
+	// error if the rover is unable to turn.
+	ChangeHeading(spatial.Direction) error
 
 	// Move attempts to move the Rover according to its implementation specific
 	// rules.
 	Move() error
+
+	// Reverse attempts to move the Rover backward (opposite to its heading,
+	// which is unchanged) according to the same rules as Move.
+	Reverse() error
+}
+
+// ElevationReporter is a rover that can report the elevation of its current
+// position.
+type ElevationReporter interface {
+	// CurrentElevation must report the elevation of the rover's current
+	// position.
+	CurrentElevation() (int, error)
+}
+
+// TelemetryReporter is a rover that keeps a record of its activity.
+type TelemetryReporter interface {
+	// Telemetry must report the rover's activity since it was launched. The
+	// returned record must not be affected by the rover's subsequent
+	// activity.
+	Telemetry() rovertypes.Telemetry
+}
+
+// BatteryPowered is a rover that draws energy from a battery.
+type BatteryPowered interface {
+	// RemainingEnergy must report the energy remaining within the rover's
+	// battery, and whether the rover has a battery at all.
+	RemainingEnergy() (int, bool)
+
+	// Charge must restore energy to the rover's battery.
+	Charge()
 }
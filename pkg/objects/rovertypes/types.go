@@ -0,0 +1,72 @@
+// Package rovertypes provides public types associated with rover behavior.
+package rovertypes
+
+// BlockReason describes why a rover's move was blocked.
+type BlockReason int
+
+// Reasons that a rover's move can be blocked.
+const (
+	// BlockedByObject indicates that another object occupied the position
+	// that the rover attempted to move to.
+	BlockedByObject BlockReason = iota
+
+	// BlockedBySlope indicates that the position that the rover attempted to
+	// move to was too far above or below the rover.
+	BlockedBySlope
+
+	// BlockedByEnvironment indicates that the rover's environment rejected
+	// the move (typically because the move would leave the environment's
+	// bounds).
+	BlockedByEnvironment
+
+	// BlockedByEnergy indicates that the rover's battery held too little
+	// energy for the rover to move (or to turn).
+	BlockedByEnergy
+)
+
+// String returns a short description of the reason, such as "object".
+func (r BlockReason) String() string {
+	switch r {
+	case BlockedByObject:
+		return "object"
+	case BlockedBySlope:
+		return "slope"
+	case BlockedByEnvironment:
+		return "environment"
+	case BlockedByEnergy:
+		return "energy"
+	default:
+		return "unknown"
+	}
+}
+
+// Telemetry is a record of the activity of a rover since it was launched.
+type Telemetry struct {
+	// InstructionsExecuted is the number of instructions (moves, turns, and
+	// charges) that the rover has attempted, including moves that were
+	// blocked.
+	InstructionsExecuted int
+
+	// CellsTraveled is the number of moves that changed the rover's
+	// position.
+	CellsTraveled int
+
+	// LeftTurns and RightTurns are the number of times that the rover has
+	// turned in each direction.
+	LeftTurns  int
+	RightTurns int
+
+	// BlockedMoves is the number of moves that were blocked, by reason.
+	// Reasons for which no moves were blocked are absent.
+	BlockedMoves map[BlockReason]int
+}
+
+// TotalBlockedMoves returns the number of moves that were blocked, regardless
+// of the reason.
+func (t Telemetry) TotalBlockedMoves() int {
+	total := 0
+	for _, count := range t.BlockedMoves {
+		total += count
+	}
+	return total
+}
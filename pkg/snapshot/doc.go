@@ -0,0 +1,4 @@
+// Package snapshot saves the state of an environment (its bounds, and the
+// objects within it) as a stable JSON document, and restores a working
+// environment from such a document.
+package snapshot
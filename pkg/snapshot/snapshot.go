@@ -0,0 +1,236 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects"
+	"github.com/jecolasurdo/marsrover/pkg/objects/roveriface"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+// Version is the version of the snapshot format produced by Take.
+const Version = 1
+
+// Kinds of objects that can be saved in a snapshot.
+const (
+	KindRover    = "rover"
+	KindObstacle = "obstacle"
+)
+
+// A Snapshot is the saved state of an environment.
+type Snapshot struct {
+	// Version is the version of the snapshot format.
+	Version int `json:"version"`
+
+	// Bounds are the bounds of the environment.
+	Bounds Bounds `json:"bounds"`
+
+	// Objects are the objects within the environment, ordered by position
+	// (bottom row first, then left to right), and then by ID.
+	Objects []Object `json:"objects"`
+}
+
+// Bounds are the saved bounds of an environment.
+type Bounds struct {
+	Min Position `json:"min"`
+	Max Position `json:"max"`
+}
+
+// A Position is a saved position within an environment.
+type Position struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// An Object is a saved object within an environment.
+type Object struct {
+	// ID is the ID of the object.
+	ID string `json:"id"`
+
+	// Kind is the kind of object (KindRover or KindObstacle).
+	Kind string `json:"kind"`
+
+	// Position is the object's position within the environment.
+	Position Position `json:"position"`
+
+	// Heading is a rover's heading ("N", "E", "S", or "W"). Heading is only
+	// set for rovers.
+	Heading string `json:"heading,omitempty"`
+
+	// Obstacle is an obstacle's kind ("rock" or "crater"). Obstacle is only
+	// set for obstacles.
+	Obstacle string `json:"obstacle,omitempty"`
+}
+
+// Take saves the state of an environment.
+//
+// The environment must be a rectangular, flat *environment.Plateau (or an
+// AtomicEnvironmenter, such as an *environment.SyncEnvironment, that wraps
+// one). Other environments cannot be rebuilt by Restore, so an
+// *UnsupportedEnvironmentError is returned for a torus, for a plateau with a
+// height map or a mask attached, and for any other kind of environment.
+//
+// The environment may only contain rovers (any roveriface.RoverAPI) and
+// obstacles (*environment.Obstacle). If any other object is present, an
+// *UnsupportedObjectError is returned.
+//
+// Only each rover's ID, position and heading are saved. A rover's options
+// (such as its MaxSlope, or its Battery and the energy remaining within it)
+// are not saved; see Restore.
+func Take(env environmentiface.Environmenter) (*Snapshot, error) {
+	if err := verifyEnvironment(env); err != nil {
+		return nil, err
+	}
+
+	bounds := env.GetBounds()
+	snapshot := &Snapshot{
+		Version: Version,
+		Bounds: Bounds{
+			Min: positionFromPoint(bounds.Min),
+			Max: positionFromPoint(bounds.Max),
+		},
+		Objects: []Object{},
+	}
+
+	for point, objectsAtPoint := range env.ShowObjects() {
+		for _, object := range objectsAtPoint {
+			saved := Object{
+				ID:       object.ID(),
+				Position: positionFromPoint(point),
+			}
+			switch o := object.(type) {
+			case roveriface.RoverAPI:
+				saved.Kind = KindRover
+				saved.Heading = spatial.HeadingToString(o.CurrentHeading())
+			case *environment.Obstacle:
+				saved.Kind = KindObstacle
+				saved.Obstacle = string(o.Kind())
+			default:
+				return nil, ErrUnsupportedObject(object.ID())
+			}
+			snapshot.Objects = append(snapshot.Objects, saved)
+		}
+	}
+
+	sort.Slice(snapshot.Objects, func(i, j int) bool {
+		a, b := snapshot.Objects[i], snapshot.Objects[j]
+		if a.Position.Y != b.Position.Y {
+			return a.Position.Y < b.Position.Y
+		}
+		if a.Position.X != b.Position.X {
+			return a.Position.X < b.Position.X
+		}
+		return a.ID < b.ID
+	})
+
+	return snapshot, nil
+}
+
+// Restore rebuilds a plateau from a snapshot, along with a live rover for each
+// rover within the snapshot. The rovers are returned in the order that they
+// appear within the snapshot, and retain their saved IDs and headings.
+//
+// Rover options are not saved (see Take), so the supplied options are applied
+// to every restored rover. For instance, supplying a Battery gives each rover a
+// fully charged battery.
+//
+// If the snapshot cannot be restored, an error is returned. This includes
+// snapshots saved with an unsupported version (*UnsupportedVersionError),
+// objects with an invalid kind, heading, or obstacle kind
+// (*InvalidObjectError), and any error returned while constructing the
+// plateau or placing the objects within it.
+func Restore(snapshot *Snapshot, options ...objects.RoverOption) (*environment.Plateau, []*objects.Rover, error) {
+	if snapshot.Version != Version {
+		return nil, nil, ErrUnsupportedVersion(snapshot.Version)
+	}
+
+	plateau, err := environment.Plateau{}.NewBoundedPlateau(spatial.NewRectangle(
+		snapshot.Bounds.Min.point(),
+		snapshot.Bounds.Max.point(),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rovers := []*objects.Rover{}
+	for _, saved := range snapshot.Objects {
+		switch saved.Kind {
+		case KindRover:
+			heading := spatial.HeadingFromString(saved.Heading)
+			if heading == spatial.HeadingUnknown {
+				return nil, nil, ErrInvalidObject(saved.ID, "heading", saved.Heading)
+			}
+
+			rover, err := objects.Rover{}.RestoreRover(saved.ID, heading, saved.Position.point(), plateau, options...)
+			if err != nil {
+				return nil, nil, err
+			}
+			rovers = append(rovers, rover)
+		case KindObstacle:
+			kind := environment.ObstacleKindFromString(saved.Obstacle)
+			if kind == environment.ObstacleUnknown {
+				return nil, nil, ErrInvalidObject(saved.ID, "obstacle", saved.Obstacle)
+			}
+
+			err := plateau.PlaceObject(environment.Obstacle{}.RestoreObstacle(saved.ID, kind), saved.Position.point())
+			if err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, ErrInvalidObject(saved.ID, "kind", saved.Kind)
+		}
+	}
+
+	return plateau, rovers, nil
+}
+
+// verifyEnvironment returns an *UnsupportedEnvironmentError if Restore could not
+// rebuild the environment from a snapshot.
+func verifyEnvironment(env environmentiface.Environmenter) error {
+	switch e := env.(type) {
+	case *environment.Plateau:
+		if e.HeightMap() != nil {
+			return ErrUnsupportedEnvironment("height map")
+		}
+		if e.Mask() != nil {
+			return ErrUnsupportedEnvironment("mask")
+		}
+		return nil
+	case *environment.Torus:
+		return ErrUnsupportedEnvironment("torus topology")
+	case environmentiface.AtomicEnvironmenter:
+		return e.Atomically(verifyEnvironment)
+	default:
+		return ErrUnsupportedEnvironment(fmt.Sprintf("environment type %T", env))
+	}
+}
+
+// Write encodes a snapshot as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// Read decodes a snapshot from JSON.
+func Read(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	err := json.NewDecoder(r).Decode(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func positionFromPoint(p spatial.Point) Position {
+	return Position{X: p.X, Y: p.Y}
+}
+
+func (p Position) point() spatial.Point {
+	return spatial.NewPoint(p.X, p.Y)
+}
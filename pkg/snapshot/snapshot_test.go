@@ -0,0 +1,275 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mock_environmentiface "github.com/jecolasurdo/marsrover/mocks/environment"
+	mock_objectiface "github.com/jecolasurdo/marsrover/mocks/objects"
+	"github.com/jecolasurdo/marsrover/pkg/environment"
+	"github.com/jecolasurdo/marsrover/pkg/environment/environmentiface"
+	"github.com/jecolasurdo/marsrover/pkg/objects"
+	"github.com/jecolasurdo/marsrover/pkg/snapshot"
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+)
+
+func Test_SnapshotRoundTrip(t *testing.T) {
+	plateau, err := environment.Plateau{}.NewBoundedPlateau(
+		spatial.NewRectangle(spatial.NewPoint(-2, -2), spatial.NewPoint(5, 5)))
+	assert.NoError(t, err)
+
+	assert.NoError(t, plateau.PlaceObject(
+		environment.Obstacle{}.RestoreObstacle("rock-1", environment.ObstacleRock), spatial.NewPoint(3, 3)))
+	_, err = objects.Rover{}.RestoreRover("rover-b", spatial.HeadingEast, spatial.NewPoint(1, 2), plateau)
+	assert.NoError(t, err)
+	_, err = objects.Rover{}.RestoreRover("rover-a", spatial.HeadingSouth, spatial.NewPoint(-2, 2), plateau)
+	assert.NoError(t, err)
+
+	saved, err := snapshot.Take(plateau)
+	assert.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, saved.Write(buffer))
+	assert.Equal(t, `{
+  "version": 1,
+  "bounds": {
+    "min": {
+      "x": -2,
+      "y": -2
+    },
+    "max": {
+      "x": 5,
+      "y": 5
+    }
+  },
+  "objects": [
+    {
+      "id": "rover-a",
+      "kind": "rover",
+      "position": {
+        "x": -2,
+        "y": 2
+      },
+      "heading": "S"
+    },
+    {
+      "id": "rover-b",
+      "kind": "rover",
+      "position": {
+        "x": 1,
+        "y": 2
+      },
+      "heading": "E"
+    },
+    {
+      "id": "rock-1",
+      "kind": "obstacle",
+      "position": {
+        "x": 3,
+        "y": 3
+      },
+      "obstacle": "rock"
+    }
+  ]
+}
+`, buffer.String())
+
+	loaded, err := snapshot.Read(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, saved, loaded)
+
+	restored, rovers, err := snapshot.Restore(loaded)
+	assert.NoError(t, err)
+	assert.Equal(t, plateau.GetBounds(), restored.GetBounds())
+	assert.Len(t, rovers, 2)
+	assert.Equal(t, "rover-a", rovers[0].ID())
+	assert.Equal(t, spatial.HeadingSouth, rovers[0].CurrentHeading())
+	assert.Equal(t, "rover-b", rovers[1].ID())
+
+	occupied, occupants, err := restored.InspectPosition(spatial.NewPoint(3, 3))
+	assert.NoError(t, err)
+	assert.True(t, occupied)
+	assert.Equal(t, "rock-1", occupants[0].ID())
+
+	// The restored rovers are live, and the restored obstacle blocks them.
+	rovers[1].ChangeHeading(spatial.DirectionLeft)
+	assert.NoError(t, rovers[1].Move())
+	rovers[1].ChangeHeading(spatial.DirectionRight)
+	assert.NoError(t, rovers[1].Move())
+	assert.EqualError(t, rovers[1].Move(),
+		objects.ErrRoverIncompatibleObjectDetected(spatial.NewPoint(3, 3), occupants...).Error())
+
+	// The original plateau is unaffected by the restored copy.
+	_, position := plateau.FindObject(rovers[1])
+	assert.Equal(t, spatial.NewPoint(1, 2), position.Position)
+}
+
+func Test_TakeUnsupportedObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	object := mock_objectiface.NewMockObjecter(ctrl)
+	object.EXPECT().ID().Return("mystery").AnyTimes()
+
+	plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+	assert.NoError(t, err)
+	assert.NoError(t, plateau.PlaceObject(object, spatial.NewPoint(1, 1)))
+
+	saved, err := snapshot.Take(plateau)
+	assert.Nil(t, saved)
+	assert.EqualError(t, err, snapshot.ErrUnsupportedObject("mystery").Error())
+}
+
+func Test_TakeUnsupportedEnvironment(t *testing.T) {
+	newPlateau := func() *environment.Plateau {
+		plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(1, 1))
+		assert.NoError(t, err)
+		return plateau
+	}
+
+	heightMapped := newPlateau()
+	heightMap, err := environment.HeightMap{}.NewHeightMap([][]int{{3, 4}, {1, 2}})
+	assert.NoError(t, err)
+	assert.NoError(t, heightMapped.SetHeightMap(heightMap))
+
+	masked := newPlateau()
+	mask, err := environment.Mask{}.NewGridMask([][]bool{{true, false}, {true, true}})
+	assert.NoError(t, err)
+	assert.NoError(t, masked.SetMask(mask))
+
+	torus, err := environment.Torus{}.NewTorus(spatial.NewPoint(1, 1))
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		env       environmentiface.Environmenter
+		expReason string
+	}{
+		{"height map", heightMapped, "height map"},
+		{"mask", masked, "mask"},
+		{"torus", torus, "torus topology"},
+		{"synchronized torus", environment.SyncEnvironment{}.NewSyncEnvironment(torus), "torus topology"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			saved, err := snapshot.Take(testCase.env)
+			assert.Nil(t, saved)
+			assert.EqualError(t, err, snapshot.ErrUnsupportedEnvironment(testCase.expReason).Error())
+
+			var unsupported *snapshot.UnsupportedEnvironmentError
+			assert.True(t, errors.As(err, &unsupported))
+		})
+	}
+
+	t.Run("other environments", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		saved, err := snapshot.Take(mock_environmentiface.NewMockEnvironmenter(ctrl))
+		assert.Nil(t, saved)
+		var unsupported *snapshot.UnsupportedEnvironmentError
+		assert.True(t, errors.As(err, &unsupported))
+	})
+
+	t.Run("synchronized plateau", func(t *testing.T) {
+		saved, err := snapshot.Take(environment.SyncEnvironment{}.NewSyncEnvironment(newPlateau()))
+		assert.NoError(t, err)
+		assert.NotNil(t, saved)
+	})
+}
+
+func Test_RestoreRoverOptions(t *testing.T) {
+	plateau, err := environment.Plateau{}.NewPlateau(spatial.NewPoint(5, 5))
+	assert.NoError(t, err)
+	_, err = objects.Rover{}.RestoreRover("rover-a", spatial.HeadingNorth, spatial.NewPoint(1, 1), plateau,
+		objects.Battery(objects.BatteryConfig{Capacity: 5, MoveCost: 1}))
+	assert.NoError(t, err)
+
+	saved, err := snapshot.Take(plateau)
+	assert.NoError(t, err)
+
+	_, rovers, err := snapshot.Restore(saved)
+	assert.NoError(t, err)
+	_, powered := rovers[0].RemainingEnergy()
+	assert.False(t, powered)
+
+	_, rovers, err = snapshot.Restore(saved, objects.Battery(objects.BatteryConfig{Capacity: 2, MoveCost: 1}))
+	assert.NoError(t, err)
+	energy, powered := rovers[0].RemainingEnergy()
+	assert.True(t, powered)
+	assert.Equal(t, 2, energy)
+}
+
+func Test_RestoreErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		document string
+		expErr   error
+	}{
+		{
+			name:     "unsupported version",
+			document: `{"version": 2, "bounds": {"max": {"x": 5, "y": 5}}}`,
+			expErr:   snapshot.ErrUnsupportedVersion(2),
+		},
+		{
+			name:     "inverted bounds",
+			document: `{"version": 1, "bounds": {"min": {"x": 6}, "max": {"x": 5, "y": 5}}}`,
+			expErr: environment.ErrInvertedBounds(
+				spatial.NewRectangle(spatial.NewPoint(6, 0), spatial.NewPoint(5, 5))),
+		},
+		{
+			name: "invalid kind",
+			document: `{"version": 1, "bounds": {"max": {"x": 5, "y": 5}}, "objects": [
+				{"id": "a", "kind": "tree", "position": {"x": 1, "y": 1}}]}`,
+			expErr: snapshot.ErrInvalidObject("a", "kind", "tree"),
+		},
+		{
+			name: "invalid heading",
+			document: `{"version": 1, "bounds": {"max": {"x": 5, "y": 5}}, "objects": [
+				{"id": "a", "kind": "rover", "position": {"x": 1, "y": 1}, "heading": "Q"}]}`,
+			expErr: snapshot.ErrInvalidObject("a", "heading", "Q"),
+		},
+		{
+			name: "invalid obstacle",
+			document: `{"version": 1, "bounds": {"max": {"x": 5, "y": 5}}, "objects": [
+				{"id": "a", "kind": "obstacle", "position": {"x": 1, "y": 1}, "obstacle": "tree"}]}`,
+			expErr: snapshot.ErrInvalidObject("a", "obstacle", "tree"),
+		},
+		{
+			name: "object outside of the bounds",
+			document: `{"version": 1, "bounds": {"max": {"x": 5, "y": 5}}, "objects": [
+				{"id": "a", "kind": "obstacle", "position": {"x": 6, "y": 1}, "obstacle": "rock"}]}`,
+			expErr: environment.ErrPositionOutsideBounds(spatial.NewPoint(6, 1)),
+		},
+		{
+			name: "duplicate IDs",
+			document: `{"version": 1, "bounds": {"max": {"x": 5, "y": 5}}, "objects": [
+				{"id": "a", "kind": "rover", "position": {"x": 1, "y": 1}, "heading": "N"},
+				{"id": "a", "kind": "rover", "position": {"x": 2, "y": 1}, "heading": "N"}]}`,
+			expErr: environment.ErrObjectAlreadyExists(environment.Obstacle{}.RestoreObstacle("a", environment.ObstacleRock)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			saved, err := snapshot.Read(strings.NewReader(testCase.document))
+			assert.NoError(t, err)
+
+			plateau, rovers, err := snapshot.Restore(saved)
+			assert.Nil(t, plateau)
+			assert.Nil(t, rovers)
+			assert.EqualError(t, err, testCase.expErr.Error())
+		})
+	}
+
+	t.Run("errors are typed", func(t *testing.T) {
+		_, _, err := snapshot.Restore(&snapshot.Snapshot{Version: 3})
+		var versionErr *snapshot.UnsupportedVersionError
+		assert.True(t, errors.As(err, &versionErr))
+		assert.Equal(t, 3, versionErr.Version)
+	})
+}
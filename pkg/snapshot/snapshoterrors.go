@@ -0,0 +1,71 @@
+package snapshot
+
+import "fmt"
+
+// UnsupportedObjectError occurs if an environment contains an object that
+// cannot be saved in a snapshot.
+type UnsupportedObjectError struct {
+	ObjectID string
+}
+
+// ErrUnsupportedObject occurs if an environment contains an object that cannot
+// be saved in a snapshot.
+func ErrUnsupportedObject(objectID string) error {
+	return &UnsupportedObjectError{ObjectID: objectID}
+}
+
+func (e *UnsupportedObjectError) Error() string {
+	return fmt.Sprintf("object with ID '%s' cannot be saved in a snapshot", e.ObjectID)
+}
+
+// UnsupportedEnvironmentError occurs if an environment cannot be saved in a
+// snapshot, because restoring the snapshot would not reproduce the environment.
+type UnsupportedEnvironmentError struct {
+	// Reason describes the feature of the environment that cannot be saved,
+	// such as "height map".
+	Reason string
+}
+
+// ErrUnsupportedEnvironment occurs if an environment cannot be saved in a
+// snapshot, because restoring the snapshot would not reproduce the environment.
+func ErrUnsupportedEnvironment(reason string) error {
+	return &UnsupportedEnvironmentError{Reason: reason}
+}
+
+func (e *UnsupportedEnvironmentError) Error() string {
+	return fmt.Sprintf("environment cannot be saved in a snapshot: %s is not supported", e.Reason)
+}
+
+// UnsupportedVersionError occurs if a snapshot was saved in a format that
+// cannot be restored.
+type UnsupportedVersionError struct {
+	Version int
+}
+
+// ErrUnsupportedVersion occurs if a snapshot was saved in a format that cannot
+// be restored.
+func ErrUnsupportedVersion(version int) error {
+	return &UnsupportedVersionError{Version: version}
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("snapshot version %v is not supported (expected version %v)", e.Version, Version)
+}
+
+// InvalidObjectError occurs if an object within a snapshot cannot be restored
+// because one of its fields is invalid.
+type InvalidObjectError struct {
+	ObjectID string
+	Field    string
+	Value    string
+}
+
+// ErrInvalidObject occurs if an object within a snapshot cannot be restored
+// because one of its fields is invalid.
+func ErrInvalidObject(objectID, field, value string) error {
+	return &InvalidObjectError{ObjectID: objectID, Field: field, Value: value}
+}
+
+func (e *InvalidObjectError) Error() string {
+	return fmt.Sprintf("object with ID '%s' has an invalid %s '%s'", e.ObjectID, e.Field, e.Value)
+}
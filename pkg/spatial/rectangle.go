@@ -0,0 +1,40 @@
+package spatial
+
+// Rectangle is an axis aligned region of a two dimensional plane. Both the Min
+// and Max corners are included within the region.
+type Rectangle struct {
+	Min Point
+	Max Point
+}
+
+// NewRectangle instantiates a new rectangle spanning from min (the lower-left
+// corner) to max (the upper-right corner).
+func NewRectangle(min, max Point) Rectangle {
+	return Rectangle{
+		Min: min,
+		Max: max,
+	}
+}
+
+// Inverted returns true if either of the rectangle's Min coordinates exceeds
+// the respective Max coordinate. An inverted rectangle contains no points.
+func (r Rectangle) Inverted() bool {
+	return r.Min.X > r.Max.X || r.Min.Y > r.Max.Y
+}
+
+// Contains returns true if the point lies within the rectangle (including its
+// edges).
+func (r Rectangle) Contains(p Point) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X &&
+		p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Width returns the number of distinct X coordinates within the rectangle.
+func (r Rectangle) Width() int {
+	return r.Max.X - r.Min.X + 1
+}
+
+// Height returns the number of distinct Y coordinates within the rectangle.
+func (r Rectangle) Height() int {
+	return r.Max.Y - r.Min.Y + 1
+}
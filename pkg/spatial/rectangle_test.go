@@ -0,0 +1,25 @@
+package spatial_test
+
+import (
+	"testing"
+
+	"github.com/jecolasurdo/marsrover/pkg/spatial"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Rectangle(t *testing.T) {
+	r := spatial.NewRectangle(spatial.NewPoint(-10, -5), spatial.NewPoint(10, 5))
+	assert.False(t, r.Inverted())
+	assert.Equal(t, 21, r.Width())
+	assert.Equal(t, 11, r.Height())
+
+	assert.True(t, r.Contains(spatial.NewPoint(-10, -5)))
+	assert.True(t, r.Contains(spatial.NewPoint(10, 5)))
+	assert.True(t, r.Contains(spatial.NewPoint(0, 0)))
+	assert.False(t, r.Contains(spatial.NewPoint(-11, 0)))
+	assert.False(t, r.Contains(spatial.NewPoint(0, 6)))
+
+	inverted := spatial.NewRectangle(spatial.NewPoint(0, 0), spatial.NewPoint(-1, 5))
+	assert.True(t, inverted.Inverted())
+	assert.False(t, inverted.Contains(spatial.NewPoint(0, 0)))
+}